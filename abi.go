@@ -0,0 +1,438 @@
+package chaintester
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/uuosio/chaintester/abigen"
+	"github.com/uuosio/chaintester/keys"
+)
+
+// abiPacker packs JSON values of the types of an ABI like the abi serializer of the chain
+type abiPacker struct {
+	abi *abigen.ABI
+}
+
+// tableType returns the struct type of the rows of table
+func tableType(abi *abigen.ABI, table string) (string, error) {
+	if t := abi.FindTable(table); t != nil {
+		return t.Type, nil
+	}
+	return "", newErrorf("table %s not found in abi", table)
+}
+
+// packJSON packs a JSON value of typ, numbers are expected as json.Number
+func packJSON(abi *abigen.ABI, typ string, value interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := (&abiPacker{abi}).pack(buf, typ, value, 0); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+const maxABIDepth = 32
+
+func (a *abiPacker) pack(buf *bytes.Buffer, typ string, value interface{}, depth int) error {
+	if depth > maxABIDepth {
+		return newErrorf("type %s is nested too deeply", typ)
+	}
+	typ, err := a.abi.ResolveType(typ)
+	if err != nil {
+		return newError(err)
+	}
+
+	if strings.HasSuffix(typ, "$") {
+		return a.pack(buf, typ[:len(typ)-1], value, depth+1)
+	}
+	if strings.HasSuffix(typ, "?") {
+		if value == nil {
+			buf.WriteByte(0)
+			return nil
+		}
+		buf.WriteByte(1)
+		return a.pack(buf, typ[:len(typ)-1], value, depth+1)
+	}
+	if strings.HasSuffix(typ, "[]") {
+		if hex, ok := value.(string); ok && typ == "uint8[]" {
+			return packBuiltin(buf, "bytes", hex)
+		}
+		items, ok := value.([]interface{})
+		if !ok {
+			return newErrorf("%s: expected an array, got %v", typ, value)
+		}
+		writeVarUint32(buf, uint32(len(items)))
+		for _, item := range items {
+			if err := a.pack(buf, typ[:len(typ)-2], item, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if v := a.abi.FindVariant(typ); v != nil {
+		return a.packVariant(buf, v.Name, v.Types, value, depth)
+	}
+	if s := a.abi.FindStruct(typ); s != nil {
+		return a.packStruct(buf, s, value, depth)
+	}
+	return packBuiltin(buf, typ, value)
+}
+
+// packVariant packs a variant given as ["type", value]
+func (a *abiPacker) packVariant(buf *bytes.Buffer, name string, types []string, value interface{}, depth int) error {
+	pair, ok := value.([]interface{})
+	if !ok || len(pair) != 2 {
+		return newErrorf("%s: expected [type, value], got %v", name, value)
+	}
+	typ, ok := pair[0].(string)
+	if !ok {
+		return newErrorf("%s: invalid variant type %v", name, pair[0])
+	}
+	for i, t := range types {
+		if t == typ {
+			writeVarUint32(buf, uint32(i))
+			return a.pack(buf, typ, pair[1], depth+1)
+		}
+	}
+	return newErrorf("%s: type %s is not in the variant", name, typ)
+}
+
+func (a *abiPacker) packStruct(buf *bytes.Buffer, s *abigen.ABIStruct, value interface{}, depth int) error {
+	typ := s.Name
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return newErrorf("%s: expected an object, got %v", typ, value)
+	}
+	if depth > maxABIDepth {
+		return newErrorf("type %s is nested too deeply", typ)
+	}
+
+	if s.Base != "" {
+		name, err := a.abi.ResolveType(s.Base)
+		if err != nil {
+			return newError(err)
+		}
+		base := a.abi.FindStruct(name)
+		if base == nil {
+			return newErrorf("%s: unknown base %s", typ, s.Base)
+		}
+		if err := a.packStruct(buf, base, value, depth+1); err != nil {
+			return err
+		}
+	}
+
+	extension := false
+	for _, field := range s.Fields {
+		v, ok := obj[field.Name]
+		if !ok {
+			// binary extensions can only be omitted at the end of a struct
+			if strings.HasSuffix(field.Type, "$") {
+				extension = true
+				continue
+			}
+			return newErrorf("%s: missing field %s", typ, field.Name)
+		}
+		if extension {
+			return newErrorf("%s: field %s follows an omitted binary extension", typ, field.Name)
+		}
+		if err := a.pack(buf, field.Type, v, depth+1); err != nil {
+			return newErrorf("%s.%s: %v", typ, field.Name, err)
+		}
+	}
+	return nil
+}
+
+func jsonString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	}
+	return "", newErrorf("expected a number or a string, got %v", value)
+}
+
+func packBuiltin(buf *bytes.Buffer, typ string, value interface{}) error {
+	le := binary.LittleEndian
+	switch typ {
+	case "bool":
+		v, ok := value.(bool)
+		if !ok {
+			return newErrorf("expected a bool, got %v", value)
+		}
+		if v {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+		return nil
+	case "int8", "int16", "int32", "int64":
+		bits, _ := strconv.Atoi(typ[3:])
+		s, err := jsonString(value)
+		if err != nil {
+			return err
+		}
+		v, err := strconv.ParseInt(s, 10, bits)
+		if err != nil {
+			return newErrorf("invalid %s: %s", typ, s)
+		}
+		b := make([]byte, 8)
+		le.PutUint64(b, uint64(v))
+		buf.Write(b[:bits/8])
+		return nil
+	case "uint8", "uint16", "uint32", "uint64":
+		bits, _ := strconv.Atoi(typ[4:])
+		s, err := jsonString(value)
+		if err != nil {
+			return err
+		}
+		v, err := strconv.ParseUint(s, 10, bits)
+		if err != nil {
+			return newErrorf("invalid %s: %s", typ, s)
+		}
+		b := make([]byte, 8)
+		le.PutUint64(b, v)
+		buf.Write(b[:bits/8])
+		return nil
+	case "int128", "uint128":
+		s, err := jsonString(value)
+		if err != nil {
+			return err
+		}
+		return packInt128(buf, typ, s)
+	case "varint32":
+		s, err := jsonString(value)
+		if err != nil {
+			return err
+		}
+		v, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return newErrorf("invalid %s: %s", typ, s)
+		}
+		writeVarUint32(buf, uint32(v<<1)^uint32(v>>31))
+		return nil
+	case "varuint32":
+		s, err := jsonString(value)
+		if err != nil {
+			return err
+		}
+		v, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return newErrorf("invalid %s: %s", typ, s)
+		}
+		writeVarUint32(buf, uint32(v))
+		return nil
+	case "float32", "float64":
+		s, err := jsonString(value)
+		if err != nil {
+			return err
+		}
+		bits, _ := strconv.Atoi(typ[5:])
+		v, err := strconv.ParseFloat(s, bits)
+		if err != nil {
+			return newErrorf("invalid %s: %s", typ, s)
+		}
+		if bits == 32 {
+			return binary.Write(buf, le, math.Float32bits(float32(v)))
+		}
+		return binary.Write(buf, le, math.Float64bits(v))
+	case "float128":
+		return packHex(buf, typ, value, 16)
+	case "checksum160":
+		return packHex(buf, typ, value, 20)
+	case "checksum256":
+		return packHex(buf, typ, value, 32)
+	case "checksum512":
+		return packHex(buf, typ, value, 64)
+	case "bytes":
+		s, ok := value.(string)
+		if !ok {
+			return newErrorf("expected a hex string, got %v", value)
+		}
+		data, err := hex.DecodeString(s)
+		if err != nil {
+			return newErrorf("invalid bytes: %v", err)
+		}
+		writeBytes(buf, data)
+		return nil
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return newErrorf("expected a string, got %v", value)
+		}
+		writeBytes(buf, []byte(s))
+		return nil
+	case "name":
+		s, ok := value.(string)
+		if !ok {
+			return newErrorf("expected a name, got %v", value)
+		}
+		return writeName(buf, s)
+	case "time_point", "time_point_sec", "block_timestamp_type":
+		return packTime(buf, typ, value)
+	case "public_key", "signature":
+		s, ok := value.(string)
+		if !ok {
+			return newErrorf("expected a %s, got %v", typ, value)
+		}
+		var data []byte
+		var err error
+		if typ == "public_key" {
+			var key *keys.PublicKey
+			if key, err = keys.ParsePublicKey(s); err == nil {
+				data, err = key.Pack()
+			}
+		} else {
+			var sig *keys.Signature
+			if sig, err = keys.ParseSignature(s); err == nil {
+				data, err = sig.Pack()
+			}
+		}
+		if err != nil {
+			return newErrorf("invalid %s %s: %v", typ, s, err)
+		}
+		buf.Write(data)
+		return nil
+	case "symbol":
+		s, ok := value.(string)
+		if !ok {
+			return newErrorf("expected a symbol, got %v", value)
+		}
+		sym, err := parseSymbol(s)
+		if err != nil {
+			return err
+		}
+		buf.Write(sym.pack())
+		return nil
+	case "symbol_code":
+		s, ok := value.(string)
+		if !ok {
+			return newErrorf("expected a symbol code, got %v", value)
+		}
+		sym, err := parseSymbol("0," + s)
+		if err != nil {
+			return err
+		}
+		buf.Write(sym.pack()[1:])
+		buf.WriteByte(0)
+		return nil
+	case "asset":
+		s, ok := value.(string)
+		if !ok {
+			return newErrorf("expected an asset, got %v", value)
+		}
+		return packAsset(buf, s)
+	case "extended_asset":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return newErrorf("expected an extended asset, got %v", value)
+		}
+		quantity, _ := obj["quantity"].(string)
+		contract, _ := obj["contract"].(string)
+		if err := packAsset(buf, quantity); err != nil {
+			return err
+		}
+		return writeName(buf, contract)
+	}
+	return newErrorf("unknown type %s", typ)
+}
+
+func packInt128(buf *bytes.Buffer, typ string, s string) error {
+	v, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return newErrorf("invalid %s: %s", typ, s)
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), 128)
+	if typ == "int128" {
+		half := new(big.Int).Rsh(limit, 1)
+		if v.Cmp(new(big.Int).Neg(half)) < 0 || v.Cmp(half) >= 0 {
+			return newErrorf("invalid %s: %s", typ, s)
+		}
+		if v.Sign() < 0 {
+			v.Add(v, limit)
+		}
+	} else if v.Sign() < 0 || v.Cmp(limit) >= 0 {
+		return newErrorf("invalid %s: %s", typ, s)
+	}
+
+	data := v.FillBytes(make([]byte, 16))
+	for i, j := 0, len(data)-1; i < j; i, j = i+1, j-1 {
+		data[i], data[j] = data[j], data[i]
+	}
+	buf.Write(data)
+	return nil
+}
+
+func packHex(buf *bytes.Buffer, typ string, value interface{}, size int) error {
+	s, ok := value.(string)
+	if !ok {
+		return newErrorf("expected a hex string, got %v", value)
+	}
+	data, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil || len(data) != size {
+		return newErrorf("invalid %s: %s", typ, s)
+	}
+	buf.Write(data)
+	return nil
+}
+
+// blockTimestampEpoch is the epoch of block_timestamp_type, which counts half seconds
+var blockTimestampEpoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func packTime(buf *bytes.Buffer, typ string, value interface{}) error {
+	s, ok := value.(string)
+	if !ok {
+		return newErrorf("expected a time, got %v", value)
+	}
+	t, err := time.Parse("2006-01-02T15:04:05.999999", strings.TrimSuffix(s, "Z"))
+	if err != nil {
+		return newErrorf("invalid %s: %s", typ, s)
+	}
+
+	switch typ {
+	case "time_point":
+		return binary.Write(buf, binary.LittleEndian, t.UnixNano()/1000)
+	case "time_point_sec":
+		if t.Unix() < 0 || t.Unix() > math.MaxUint32 {
+			return newErrorf("invalid %s: %s", typ, s)
+		}
+		return binary.Write(buf, binary.LittleEndian, uint32(t.Unix()))
+	}
+	slot := t.Sub(blockTimestampEpoch) / (500 * time.Millisecond)
+	if slot < 0 || slot > math.MaxUint32 {
+		return newErrorf("invalid %s: %s", typ, s)
+	}
+	return binary.Write(buf, binary.LittleEndian, uint32(slot))
+}
+
+// packAsset packs an asset like 1.0000 EOS, the precision is the number of decimals of the amount
+func packAsset(buf *bytes.Buffer, s string) error {
+	parts := strings.Split(s, " ")
+	if len(parts) != 2 {
+		return newErrorf("invalid asset: %q", s)
+	}
+	amount, decimals := parts[0], ""
+	if i := strings.Index(amount, "."); i >= 0 {
+		amount, decimals = amount[:i], amount[i+1:]
+	}
+	sym, err := parseSymbol(strconv.Itoa(len(decimals)) + "," + parts[1])
+	if err != nil {
+		return err
+	}
+	v, err := strconv.ParseInt(amount+decimals, 10, 64)
+	if err != nil || strings.HasPrefix(decimals, "-") || strings.HasPrefix(decimals, "+") {
+		return newErrorf("invalid asset: %q", s)
+	}
+	if err := binary.Write(buf, binary.LittleEndian, v); err != nil {
+		return err
+	}
+	buf.Write(sym.pack())
+	return nil
+}
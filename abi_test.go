@@ -0,0 +1,96 @@
+package chaintester
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/uuosio/chaintester/abigen"
+)
+
+const testABI = `{
+	"version": "eosio::abi/1.1",
+	"types": [{"new_type_name": "account_name", "type": "name"}],
+	"structs": [
+		{"name": "base", "base": "", "fields": [{"name": "id", "type": "uint64"}]},
+		{"name": "row", "base": "base", "fields": [
+			{"name": "owner", "type": "account_name"},
+			{"name": "balance", "type": "asset"},
+			{"name": "tags", "type": "string[]"},
+			{"name": "memo", "type": "string?"},
+			{"name": "value", "type": "value_type"},
+			{"name": "extra", "type": "uint32$"}
+		]}
+	],
+	"variants": [{"name": "value_type", "types": ["int8", "time_point_sec"]}],
+	"tables": [{"name": "rows", "type": "row", "index_type": "i64", "key_names": [], "key_types": []}]
+}`
+
+func packTestValue(t *testing.T, abi *abigen.ABI, typ string, value string) (string, error) {
+	t.Helper()
+	decoder := json.NewDecoder(bytes.NewReader([]byte(value)))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+	data, err := packJSON(abi, typ, v)
+	return hex.EncodeToString(data), err
+}
+
+func TestPackABI(t *testing.T) {
+	abi, err := abigen.ParseABI([]byte(testABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	typ, err := tableType(abi, "rows")
+	if err != nil || typ != "row" {
+		t.Fatalf("table type %s, %v", typ, err)
+	}
+
+	for _, test := range []struct {
+		typ   string
+		value string
+		hex   string
+	}{
+		{"row", `{"id": 1, "owner": "eosio", "balance": "1.0000 EOS", "tags": ["a"], "memo": null, "value": ["int8", -1]}`,
+			"0100000000000000" + "0000000000ea3055" + "1027000000000000" + "04454f5300000000" + "010161" + "00" + "00ff"},
+		{"row", `{"id": 1, "owner": "eosio", "balance": "-0.5 EOS", "tags": [], "memo": "m", "value": ["time_point_sec", "2020-01-01T00:00:00"], "extra": 2}`,
+			"0100000000000000" + "0000000000ea3055" + "fbffffffffffffff" + "01454f5300000000" + "00" + "01016d" + "0100e10b5e" + "02000000"},
+		{"uint64", `"18446744073709551615"`, "ffffffffffffffff"},
+		{"int128", `"-1"`, "ffffffffffffffffffffffffffffffff"},
+		{"varint32", `-1`, "01"},
+		{"bytes", `"0102"`, "020102"},
+		{"symbol", `"4,EOS"`, "04454f5300000000"},
+		{"symbol_code", `"EOS"`, "454f530000000000"},
+		{"bool", `true`, "01"},
+		{"float64", `1`, "000000000000f03f"},
+		{"block_timestamp_type", `"2000-01-01T00:00:01.000"`, "02000000"},
+		{"time_point", `"1970-01-01T00:00:00.000001"`, "0100000000000000"},
+		{"public_key", `"EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"`, "0002c0ded2bc1f1305fb0faac5e6c03ee3a1924234985427b6167ca569d13df435cf"},
+	} {
+		packed, err := packTestValue(t, abi, test.typ, test.value)
+		if err != nil || packed != test.hex {
+			t.Errorf("%s %s packed as %s, %v, expected %s", test.typ, test.value, packed, err, test.hex)
+		}
+	}
+
+	for _, test := range []struct {
+		typ   string
+		value string
+	}{
+		{"row", `{"id": 1}`},
+		{"uint8", `256`},
+		{"int128", `"170141183460469231731687303715884105728"`},
+		{"name", `"Alice"`},
+		{"asset", `"1.0000"`},
+		{"value_type", `["string", "a"]`},
+		{"checksum256", `"00"`},
+		{"unknown", `1`},
+	} {
+		if packed, err := packTestValue(t, abi, test.typ, test.value); err == nil {
+			t.Errorf("%s %s packed as %s", test.typ, test.value, packed)
+		}
+	}
+}
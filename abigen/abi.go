@@ -0,0 +1,116 @@
+package abigen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type ABITypeDef struct {
+	NewTypeName string `json:"new_type_name"`
+	Type        string `json:"type"`
+}
+
+type ABIField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type ABIStruct struct {
+	Name   string     `json:"name"`
+	Base   string     `json:"base"`
+	Fields []ABIField `json:"fields"`
+}
+
+type ABIAction struct {
+	Name              string `json:"name"`
+	Type              string `json:"type"`
+	RicardianContract string `json:"ricardian_contract"`
+}
+
+type ABITable struct {
+	Name      string   `json:"name"`
+	IndexType string   `json:"index_type"`
+	KeyNames  []string `json:"key_names"`
+	KeyTypes  []string `json:"key_types"`
+	Type      string   `json:"type"`
+}
+
+type ABIVariant struct {
+	Name  string   `json:"name"`
+	Types []string `json:"types"`
+}
+
+type ABIActionResult struct {
+	Name       string `json:"name"`
+	ResultType string `json:"result_type"`
+}
+
+type ABI struct {
+	Version       string            `json:"version"`
+	Types         []ABITypeDef      `json:"types"`
+	Structs       []ABIStruct       `json:"structs"`
+	Actions       []ABIAction       `json:"actions"`
+	Tables        []ABITable        `json:"tables"`
+	Variants      []ABIVariant      `json:"variants"`
+	ActionResults []ABIActionResult `json:"action_results"`
+}
+
+func ParseABI(data []byte) (*ABI, error) {
+	abi := &ABI{}
+	if err := json.Unmarshal(data, abi); err != nil {
+		return nil, fmt.Errorf("parse abi: %v", err)
+	}
+	if !strings.HasPrefix(abi.Version, "eosio::abi/") {
+		return nil, fmt.Errorf("unsupported abi version: %q", abi.Version)
+	}
+	return abi, nil
+}
+
+// FindStruct returns the struct name, nil if it is not declared
+func (abi *ABI) FindStruct(name string) *ABIStruct {
+	for i := range abi.Structs {
+		if abi.Structs[i].Name == name {
+			return &abi.Structs[i]
+		}
+	}
+	return nil
+}
+
+// FindVariant returns the variant name, nil if it is not declared
+func (abi *ABI) FindVariant(name string) *ABIVariant {
+	for i := range abi.Variants {
+		if abi.Variants[i].Name == name {
+			return &abi.Variants[i]
+		}
+	}
+	return nil
+}
+
+// FindTable returns the table name, nil if it is not declared
+func (abi *ABI) FindTable(name string) *ABITable {
+	for i := range abi.Tables {
+		if abi.Tables[i].Name == name {
+			return &abi.Tables[i]
+		}
+	}
+	return nil
+}
+
+// ResolveType resolves type aliases declared in types
+func (abi *ABI) ResolveType(name string) (string, error) {
+	for i := 0; i <= len(abi.Types); i++ {
+		found := false
+		for _, t := range abi.Types {
+			if t.NewTypeName == name {
+				name = t.Type
+				found = true
+				break
+			}
+		}
+		if !found {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("circular type alias: %s", name)
+}
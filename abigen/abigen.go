@@ -0,0 +1,459 @@
+// Package abigen generates typed Go clients of contracts from their ABI files.
+//
+// A generated client has one method per action with Go typed parameters, a typed
+// accessor per table built on ChainTester.GetTableRowsEx and a decoder per action
+// return value declared in action_results.
+package abigen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+var builtinTypes = map[string]string{
+	"bool":                 "bool",
+	"int8":                 "int8",
+	"uint8":                "uint8",
+	"int16":                "int16",
+	"uint16":               "uint16",
+	"int32":                "int32",
+	"uint32":               "uint32",
+	"int64":                "chaintester.JsonInt64",
+	"uint64":               "chaintester.JsonUint64",
+	"int128":               "string",
+	"uint128":              "string",
+	"varint32":             "int32",
+	"varuint32":            "uint32",
+	"float32":              "float32",
+	"float64":              "float64",
+	"float128":             "string",
+	"time_point":           "string",
+	"time_point_sec":       "string",
+	"block_timestamp_type": "string",
+	"name":                 "string",
+	"bytes":                "string",
+	"string":               "string",
+	"checksum160":          "string",
+	"checksum256":          "string",
+	"checksum512":          "string",
+	"public_key":           "string",
+	"signature":            "string",
+	"symbol":               "string",
+	"symbol_code":          "string",
+	"asset":                "string",
+	"extended_asset":       "ExtendedAsset",
+}
+
+// names which are declared by the generated code
+var reservedNames = map[string]bool{
+	"Client":        true,
+	"NewClient":     true,
+	"ExtendedAsset": true,
+}
+
+// GoName converts an ABI name like "set.value" or "from_account" to an exported Go identifier
+func GoName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, c := range name {
+		switch {
+		case c == '_' || c == '.' || c == '-':
+			upper = true
+		case c >= 'a' && c <= 'z':
+			if upper {
+				c -= 'a' - 'A'
+			}
+			upper = false
+			b.WriteRune(c)
+		case c >= 'A' && c <= 'Z':
+			upper = false
+			b.WriteRune(c)
+		case c >= '0' && c <= '9':
+			if b.Len() == 0 {
+				b.WriteRune('X')
+			}
+			upper = false
+			b.WriteRune(c)
+		}
+	}
+	if b.Len() == 0 {
+		return "X"
+	}
+	return b.String()
+}
+
+type generator struct {
+	abi     *ABI
+	pkg     string
+	buf     bytes.Buffer
+	structs map[string]string
+	methods map[string]bool
+	// extended_asset is used
+	extendedAsset bool
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// unique appends suffix to name until it is not used, ABI names like set.value
+// and setvalue are converted to the same Go name
+func unique(name string, suffix string, used func(string) bool) string {
+	for used(name) {
+		name += suffix
+	}
+	return name
+}
+
+// declared returns true if name is declared in the scope of the package
+func (g *generator) declared(name string) bool {
+	if reservedNames[name] {
+		return true
+	}
+	for _, other := range g.structs {
+		if other == name {
+			return true
+		}
+	}
+	return false
+}
+
+// structName returns the Go type name of an ABI struct
+func (g *generator) structName(name string) string {
+	if goName, ok := g.structs[name]; ok {
+		return goName
+	}
+
+	goName := unique(GoName(name), "Struct", g.declared)
+	g.structs[name] = goName
+	return goName
+}
+
+// fieldNames returns the Go names of fields, which are unique in a struct
+func fieldNames(fields []ABIField) []string {
+	used := make(map[string]bool, len(fields))
+	names := make([]string, 0, len(fields))
+	for _, f := range fields {
+		name := unique(GoName(f.Name), "Field", func(name string) bool { return used[name] })
+		used[name] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// methodName returns a unique name of a method of Client
+func (g *generator) methodName(name string, suffix string) string {
+	name = unique(name, suffix, func(name string) bool { return g.methods[name] })
+	g.methods[name] = true
+	return name
+}
+
+func (g *generator) goType(abiType string) (string, error) {
+	switch {
+	case strings.HasSuffix(abiType, "[]"):
+		t, err := g.goType(strings.TrimSuffix(abiType, "[]"))
+		return "[]" + t, err
+	case strings.HasSuffix(abiType, "?"):
+		t, err := g.goType(strings.TrimSuffix(abiType, "?"))
+		return "*" + t, err
+	case strings.HasSuffix(abiType, "$"):
+		t, err := g.goType(strings.TrimSuffix(abiType, "$"))
+		return "*" + t, err
+	}
+
+	resolved, err := g.abi.ResolveType(abiType)
+	if err != nil {
+		return "", err
+	}
+	if resolved != abiType {
+		return g.goType(resolved)
+	}
+
+	if t, ok := builtinTypes[abiType]; ok {
+		if abiType == "extended_asset" {
+			g.extendedAsset = true
+		}
+		return t, nil
+	}
+	if g.abi.FindStruct(abiType) != nil {
+		return g.structName(abiType), nil
+	}
+	if g.abi.FindVariant(abiType) != nil {
+		// ["type", value]
+		return "json.RawMessage", nil
+	}
+	return "", fmt.Errorf("unknown abi type: %s", abiType)
+}
+
+// fields returns the fields of a struct including the fields of its bases
+func (g *generator) fields(s *ABIStruct) ([]ABIField, error) {
+	var fields []ABIField
+	seen := map[string]bool{}
+	for depth := 0; s != nil; depth++ {
+		if depth > len(g.abi.Structs) {
+			return nil, fmt.Errorf("circular base of struct %s", s.Name)
+		}
+		fields = append(append([]ABIField{}, s.Fields...), fields...)
+		if s.Base == "" {
+			break
+		}
+		if seen[s.Base] {
+			return nil, fmt.Errorf("circular base of struct %s", s.Name)
+		}
+		seen[s.Base] = true
+		base := g.abi.FindStruct(s.Base)
+		if base == nil {
+			return nil, fmt.Errorf("unknown base %s of struct %s", s.Base, s.Name)
+		}
+		s = base
+	}
+	return fields, nil
+}
+
+func (g *generator) genStruct(s *ABIStruct) error {
+	fields, err := g.fields(s)
+	if err != nil {
+		return err
+	}
+
+	g.printf("type %s struct {\n", g.structName(s.Name))
+	names := fieldNames(fields)
+	for i, f := range fields {
+		t, err := g.goType(f.Type)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %v", s.Name, f.Name, err)
+		}
+		omitempty := ""
+		if strings.HasSuffix(f.Type, "$") {
+			omitempty = ",omitempty"
+		}
+		g.printf("\t%s %s `json:\"%s%s\"`\n", names[i], t, f.Name, omitempty)
+	}
+	g.printf("}\n\n")
+	return nil
+}
+
+func (g *generator) genAction(action *ABIAction) error {
+	s := g.abi.FindStruct(action.Type)
+	if s == nil {
+		return fmt.Errorf("unknown type %s of action %s", action.Type, action.Name)
+	}
+	fields, err := g.fields(s)
+	if err != nil {
+		return err
+	}
+
+	params := make([]string, 0, len(fields)+1)
+	values := make([]string, 0, len(fields))
+	names := fieldNames(fields)
+	for i, f := range fields {
+		t, err := g.goType(f.Type)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %v", s.Name, f.Name, err)
+		}
+		param := "_" + names[i]
+		params = append(params, fmt.Sprintf("%s %s", param, t))
+		values = append(values, fmt.Sprintf("%s: %s,", names[i], param))
+	}
+	params = append(params, "auth ...chaintester.PermissionLevel")
+
+	method := g.methodName(GoName(action.Name), "Action")
+	g.printf("// %s pushes the %s action, the contract account@active is used if auth is empty\n", method, action.Name)
+	g.printf("func (c *Client) %s(%s) (*chaintester.JsonValue, error) {\n", method, strings.Join(params, ", "))
+	g.printf("\treturn c.pushAction(%q, &%s{\n", action.Name, g.structName(s.Name))
+	for _, v := range values {
+		g.printf("\t\t%s\n", v)
+	}
+	g.printf("\t}, auth)\n}\n\n")
+	return nil
+}
+
+func (g *generator) genTable(table *ABITable) error {
+	if g.abi.FindStruct(table.Type) == nil {
+		return fmt.Errorf("unknown type %s of table %s", table.Type, table.Name)
+	}
+	rowType := g.structName(table.Type)
+	name := unique(GoName(table.Name), "Table", func(name string) bool { return g.methods["Get"+name+"Rows"] })
+	method := "Get" + name + "Rows"
+	g.methods[method] = true
+
+	g.printf("// %s returns rows of table %s in scope, more is true if there are more rows after the last one\n", method, table.Name)
+	g.printf("func (c *Client) %s(scope string, lowerBound string, upperBound string, limit int64, reverse bool) (rows []%s, more bool, err error) {\n", method, rowType)
+	g.printf("\tret, err := c.tester.GetTableRowsEx(true, c.account, scope, %q, lowerBound, upperBound, limit, \"\", \"\", \"\", reverse, false)\n", table.Name)
+	g.printf("\tif err != nil {\n\t\treturn nil, false, err\n\t}\n")
+	g.printf("\tmore, err = decodeRows(ret, &rows)\n")
+	g.printf("\treturn rows, more, err\n}\n\n")
+	return nil
+}
+
+func (g *generator) genActionResult(result *ABIActionResult) error {
+	t, err := g.goType(result.ResultType)
+	if err != nil {
+		return fmt.Errorf("result of %s: %v", result.Name, err)
+	}
+
+	name := unique(GoName(result.Name), "Action", func(name string) bool { return g.methods["Decode"+name+"Result"] })
+	method := "Decode" + name + "Result"
+	g.methods[method] = true
+	g.printf("// %s decodes the return value of the first %s action of the contract in a transaction trace\n", method, result.Name)
+	g.printf("func (c *Client) %s(trace *chaintester.JsonValue) (result %s, err error) {\n", method, t)
+	g.printf("\terr = decodeReturnValue(trace, c.account, %q, &result)\n", result.Name)
+	g.printf("\treturn result, err\n}\n\n")
+	return nil
+}
+
+const header = `// Code generated by chaintester-gen. DO NOT EDIT.
+
+package %s
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/uuosio/chaintester"
+)
+
+var _ = json.RawMessage{}
+
+type Client struct {
+	tester  *chaintester.ChainTester
+	account string
+}
+
+// NewClient returns a client of the contract deployed to account
+func NewClient(tester *chaintester.ChainTester, account string) *Client {
+	return &Client{tester, account}
+}
+
+func (c *Client) pushAction(action string, args interface{}, auth []chaintester.PermissionLevel) (*chaintester.JsonValue, error) {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+	if len(auth) == 0 {
+		auth = []chaintester.PermissionLevel{{Actor: c.account, Permission: "active"}}
+	}
+
+	sender := chaintester.NewActionSender(c.tester)
+	if err := sender.AddActionWithAuth(c.account, action, string(data), auth...); err != nil {
+		return nil, err
+	}
+	return sender.Send()
+}
+
+func decodeRows(ret *chaintester.JsonValue, rows interface{}) (bool, error) {
+	data, err := json.Marshal(ret)
+	if err != nil {
+		return false, err
+	}
+
+	var result struct {
+		Rows json.RawMessage ` + "`json:\"rows\"`" + `
+		More interface{}     ` + "`json:\"more\"`" + `
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(result.Rows, rows); err != nil {
+		return false, err
+	}
+
+	switch more := result.More.(type) {
+	case bool:
+		return more, nil
+	case string:
+		return more != "", nil
+	default:
+		return false, nil
+	}
+}
+
+func decodeReturnValue(trace *chaintester.JsonValue, account string, action string, result interface{}) error {
+	data, err := json.Marshal(trace)
+	if err != nil {
+		return err
+	}
+
+	var _trace struct {
+		ActionTraces []struct {
+			Act struct {
+				Account string ` + "`json:\"account\"`" + `
+				Name    string ` + "`json:\"name\"`" + `
+			} ` + "`json:\"act\"`" + `
+			ReturnValueData json.RawMessage ` + "`json:\"return_value_data\"`" + `
+		} ` + "`json:\"action_traces\"`" + `
+	}
+	if err := json.Unmarshal(data, &_trace); err != nil {
+		return err
+	}
+
+	for _, actionTrace := range _trace.ActionTraces {
+		if actionTrace.Act.Account != account || actionTrace.Act.Name != action {
+			continue
+		}
+		if len(actionTrace.ReturnValueData) == 0 {
+			return fmt.Errorf("action %%s::%%s has no return value", account, action)
+		}
+		return json.Unmarshal(actionTrace.ReturnValueData, result)
+	}
+	return fmt.Errorf("action %%s::%%s not found in trace", account, action)
+}
+
+`
+
+// Generate generates the Go source of a client package of abi
+func Generate(abi *ABI, pkg string) ([]byte, error) {
+	g := &generator{
+		abi:     abi,
+		pkg:     pkg,
+		structs: make(map[string]string),
+		methods: make(map[string]bool),
+	}
+	g.printf(header, pkg)
+
+	for i := range abi.Actions {
+		if err := g.genAction(&abi.Actions[i]); err != nil {
+			return nil, err
+		}
+	}
+	for i := range abi.Tables {
+		if err := g.genTable(&abi.Tables[i]); err != nil {
+			return nil, err
+		}
+	}
+	for i := range abi.ActionResults {
+		if err := g.genActionResult(&abi.ActionResults[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	// every struct is generated, nested types referenced by name are always declared
+	structs := make([]*ABIStruct, 0, len(abi.Structs))
+	for i := range abi.Structs {
+		structs = append(structs, &abi.Structs[i])
+	}
+	sort.SliceStable(structs, func(i, j int) bool {
+		return structs[i].Name < structs[j].Name
+	})
+	for _, s := range structs {
+		if err := g.genStruct(s); err != nil {
+			return nil, err
+		}
+	}
+
+	if g.extendedAsset {
+		g.printf("type ExtendedAsset struct {\n")
+		g.printf("\tQuantity string `json:\"quantity\"`\n")
+		g.printf("\tContract string `json:\"contract\"`\n")
+		g.printf("}\n")
+	}
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %v", err)
+	}
+	return src, nil
+}
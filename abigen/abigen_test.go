@@ -0,0 +1,168 @@
+package abigen
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGoName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"inc":          "Inc",
+		"from_account": "FromAccount",
+		"set.value":    "SetValue",
+		"value1":       "Value1",
+		"1st":          "X1st",
+	} {
+		if got := GoName(name); got != expected {
+			t.Errorf("GoName(%q) = %q, expected %q", name, got, expected)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	data, err := os.ReadFile("testdata/hello.abi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	abi, err := ParseABI(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := Generate(abi, "hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code := string(src)
+	for _, expected := range []string{
+		"package hello",
+		"func (c *Client) Inc(_Name string, auth ...chaintester.PermissionLevel) (*chaintester.JsonValue, error)",
+		"func (c *Client) Test(_Values []int32, _Memo *string, _Fee *ExtendedAsset, auth ...chaintester.PermissionLevel)",
+		"func (c *Client) Assert(auth ...chaintester.PermissionLevel)",
+		"func (c *Client) GetCounterRows(scope string, lowerBound string, upperBound string, limit int64, reverse bool) (rows []Counter, more bool, err error)",
+		"func (c *Client) DecodeIncResult(trace *chaintester.JsonValue) (result chaintester.JsonUint64, err error)",
+		"Count chaintester.JsonUint64 `json:\"count\"`",
+		"Fee    *ExtendedAsset `json:\"fee,omitempty\"`",
+		"type ExtendedAsset struct",
+	} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("%q not found in generated code:\n%s", expected, code)
+		}
+	}
+	buildPackage(t, "hello", src)
+}
+
+func TestGenerateErrors(t *testing.T) {
+	if _, err := ParseABI([]byte(`{"version": "1.0"}`)); err == nil {
+		t.Error("unsupported version accepted")
+	}
+
+	abi := &ABI{
+		Version: "eosio::abi/1.1",
+		Types:   []ABITypeDef{{"a", "b"}, {"b", "a"}},
+		Structs: []ABIStruct{{Name: "s", Fields: []ABIField{{"f", "a"}}}},
+	}
+	if _, err := Generate(abi, "x"); err == nil || !strings.Contains(err.Error(), "circular") {
+		t.Errorf("expected circular alias error, got %v", err)
+	}
+
+	abi.Types = nil
+	abi.Structs[0].Fields[0].Type = "unknown"
+	if _, err := Generate(abi, "x"); err == nil || !strings.Contains(err.Error(), "unknown abi type") {
+		t.Errorf("expected unknown type error, got %v", err)
+	}
+}
+
+const collisionABI = `{
+	"version": "eosio::abi/1.1",
+	"structs": [
+		{"name": "set.value", "base": "", "fields": [{"name": "a_b", "type": "uint64"}, {"name": "a.b", "type": "string"}]},
+		{"name": "set_value", "base": "", "fields": [{"name": "value", "type": "extended_asset"}]},
+		{"name": "decode.set_value.result", "base": "", "fields": []}
+	],
+	"actions": [
+		{"name": "set.value", "type": "set.value", "ricardian_contract": ""},
+		{"name": "set_value", "type": "set_value", "ricardian_contract": ""},
+		{"name": "get.abc.rows", "type": "set_value", "ricardian_contract": ""}
+	],
+	"tables": [
+		{"name": "abc", "index_type": "i64", "key_names": [], "key_types": [], "type": "set_value"},
+		{"name": "abc.table", "index_type": "i64", "key_names": [], "key_types": [], "type": "set_value"}
+	],
+	"action_results": [
+		{"name": "set.value", "result_type": "uint64"},
+		{"name": "set_value", "result_type": "uint64"}
+	]
+}`
+
+func TestGenerateCollisions(t *testing.T) {
+	abi, err := ParseABI([]byte(collisionABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := Generate(abi, "collision")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code := string(src)
+	for _, expected := range []string{
+		"func (c *Client) SetValue(_AB chaintester.JsonUint64, _ABField string, auth ...chaintester.PermissionLevel)",
+		"func (c *Client) SetValueAction(_Value ExtendedAsset, auth ...chaintester.PermissionLevel)",
+		"func (c *Client) GetAbcRows(_Value ExtendedAsset, auth ...chaintester.PermissionLevel)",
+		"func (c *Client) GetAbcTableRows(",
+		"func (c *Client) GetAbcTableTableRows(",
+		"func (c *Client) DecodeSetValueResult(",
+		"func (c *Client) DecodeSetValueActionResult(",
+		"type SetValueStruct struct",
+		"type DecodeSetValueResult struct",
+	} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("%q not found in generated code:\n%s", expected, code)
+		}
+	}
+
+	buildPackage(t, "collision", src)
+}
+
+// buildPackage builds and vets a generated package against this module, the
+// dependencies of the module are resolved from the module cache or the proxy
+func buildPackage(t *testing.T, pkg string, src []byte) {
+	t.Helper()
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go not found")
+	}
+	root, err := filepath.Abs("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	gomod := "module " + pkg + "\n\ngo 1.17\n\nrequire github.com/uuosio/chaintester v0.0.0\n\nreplace github.com/uuosio/chaintester => " + root + "\n"
+	for name, data := range map[string][]byte{
+		"go.mod":    []byte(gomod),
+		"go.sum":    sum,
+		pkg + ".go": src,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, args := range [][]string{{"mod", "tidy"}, {"build", "./..."}, {"vet", "./..."}} {
+		cmd := exec.Command(goTool, args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("go %s: %v\n%s", strings.Join(args, " "), err, out)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package chaintester
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ResourceLimit is the usage and limit of net or cpu of an account
+type ResourceLimit struct {
+	Used      JsonInt64 `json:"used"`
+	Available JsonInt64 `json:"available"`
+	Max       JsonInt64 `json:"max"`
+}
+
+// AccountInfo is the resource usage of an account returned by GetAccount
+type AccountInfo struct {
+	AccountName string `json:"account_name"`
+	Privileged  bool   `json:"privileged"`
+	// RAMQuota is -1 if the RAM of the account is unlimited
+	RAMQuota  JsonInt64     `json:"ram_quota"`
+	RAMUsage  JsonInt64     `json:"ram_usage"`
+	NetWeight JsonInt64     `json:"net_weight"`
+	CPUWeight JsonInt64     `json:"cpu_weight"`
+	NetLimit  ResourceLimit `json:"net_limit"`
+	CPULimit  ResourceLimit `json:"cpu_limit"`
+}
+
+func NewAccountInfo(value *JsonValue) (*AccountInfo, error) {
+	info := &AccountInfo{}
+	if err := json.Unmarshal(value.raw, info); err != nil {
+		return nil, newErrorf("invalid account info: %v", err)
+	}
+	if info.AccountName == "" {
+		return nil, newErrorf("account_name: key not found")
+	}
+	return info, nil
+}
+
+// AvailableRAM returns the bytes of RAM the account can still use, -1 if it is unlimited
+func (info *AccountInfo) AvailableRAM() int64 {
+	if info.RAMQuota < 0 {
+		return -1
+	}
+	return int64(info.RAMQuota - info.RAMUsage)
+}
+
+// GetAccountInfo returns the result of GetAccount as an AccountInfo
+func (p *ChainTester) GetAccountInfo(account string) (*AccountInfo, error) {
+	value, err := p.GetAccount(account)
+	if err != nil {
+		return nil, err
+	}
+	return NewAccountInfo(value)
+}
+
+// RAMSnapshot is the RAM usage of accounts
+type RAMSnapshot map[string]int64
+
+// SnapshotRAM returns the RAM usage of accounts
+func (p *ChainTester) SnapshotRAM(accounts ...string) (RAMSnapshot, error) {
+	snapshot := make(RAMSnapshot, len(accounts))
+	for _, account := range accounts {
+		info, err := p.GetAccountInfo(account)
+		if err != nil {
+			return nil, err
+		}
+		snapshot[account] = int64(info.RAMUsage)
+	}
+	return snapshot, nil
+}
+
+// RAMDiff is the change of the RAM usage of accounts
+type RAMDiff map[string]int64
+
+// Diff returns the change of RAM usage from s to after of the accounts in both snapshots
+func (s RAMSnapshot) Diff(after RAMSnapshot) RAMDiff {
+	diff := make(RAMDiff, len(s))
+	for account, usage := range s {
+		if afterUsage, ok := after[account]; ok {
+			diff[account] = afterUsage - usage
+		}
+	}
+	return diff
+}
+
+func (d RAMDiff) String() string {
+	accounts := make([]string, 0, len(d))
+	for account := range d {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+
+	s := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		s = append(s, fmt.Sprintf("%s: %+d", account, d[account]))
+	}
+	return strings.Join(s, ", ")
+}
+
+// MeasureRAM returns the change of the RAM usage of accounts made by f, which usually pushes an action
+func (p *ChainTester) MeasureRAM(accounts []string, f func() error) (RAMDiff, error) {
+	before, err := p.SnapshotRAM(accounts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := f(); err != nil {
+		return nil, err
+	}
+	after, err := p.SnapshotRAM(accounts...)
+	if err != nil {
+		return nil, err
+	}
+	return before.Diff(after), nil
+}
+
+// RequireRAMDiff fails the test if the RAM usage of accounts changed by f does not match expected,
+// accounts which are not in expected must not change
+func (p *ChainTester) RequireRAMDiff(t TestingT, accounts []string, expected RAMDiff, f func() error) {
+	t.Helper()
+	diff, err := p.MeasureRAM(accounts, f)
+	if err != nil {
+		t.Fatalf("measure RAM: %v", err)
+	}
+	for _, account := range accounts {
+		if diff[account] != expected[account] {
+			t.Errorf("RAM usage changed by %s, expected %s", diff, expected)
+			return
+		}
+	}
+}
+
+// TableRow is a row of a table and the account which paid for its RAM
+type TableRow struct {
+	Data  *JsonValue
+	Payer string
+}
+
+type tableRowsWithPayer struct {
+	Rows []struct {
+		Data  json.RawMessage `json:"data"`
+		Payer string          `json:"payer"`
+	} `json:"rows"`
+	More    bool   `json:"more"`
+	NextKey string `json:"next_key"`
+}
+
+func parseTableRowsWithPayer(value *JsonValue) ([]TableRow, bool, string, error) {
+	var result tableRowsWithPayer
+	if err := json.Unmarshal(value.raw, &result); err != nil {
+		return nil, false, "", newErrorf("invalid table rows: %v", err)
+	}
+
+	rows := make([]TableRow, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		if row.Payer == "" {
+			return nil, false, "", newErrorf("table row without payer: %s", string(row.Data))
+		}
+		rows = append(rows, TableRow{NewJsonValue(row.Data), row.Payer})
+	}
+	return rows, result.More, result.NextKey, nil
+}
+
+// GetTableRowsWithPayer returns the rows of a table decoded by the ABI of code with their payers,
+// more is true if there are more rows after limit rows
+func (p *ChainTester) GetTableRowsWithPayer(code string, scope string, table string, lowerBound string, upperBound string, limit int64) (rows []TableRow, more bool, err error) {
+	value, err := p.GetTableRowsEx(true, code, scope, table, lowerBound, upperBound, limit, "", "", "", false, true)
+	if err != nil {
+		return nil, false, err
+	}
+	rows, more, _, err = parseTableRowsWithPayer(value)
+	return rows, more, err
+}
+
+// getAllTableRowsWithPayer returns all the rows of a table with their payers
+func (p *ChainTester) getAllTableRowsWithPayer(code string, scope string, table string) ([]TableRow, error) {
+	var rows []TableRow
+	lowerBound := ""
+	for {
+		value, err := p.GetTableRowsEx(true, code, scope, table, lowerBound, "", 100, "", "", "", false, true)
+		if err != nil {
+			return nil, err
+		}
+		page, more, nextKey, err := parseTableRowsWithPayer(value)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, page...)
+		if !more || nextKey == "" {
+			return rows, nil
+		}
+		lowerBound = nextKey
+	}
+}
+
+// RequireRowPayer fails the test if the row of primaryKey in a table does not exist or was not paid by payer
+func (p *ChainTester) RequireRowPayer(t TestingT, code string, scope string, table string, primaryKey string, payer string) {
+	t.Helper()
+	rows, _, err := p.GetTableRowsWithPayer(code, scope, table, primaryKey, primaryKey, 1)
+	if err != nil {
+		t.Fatalf("get rows of %s %s %s: %v", code, scope, table, err)
+	}
+	if len(rows) == 0 {
+		t.Fatalf("row %s not found in %s %s %s", primaryKey, code, scope, table)
+	}
+	if rows[0].Payer != payer {
+		t.Errorf("row %s in %s %s %s is paid by %s, expected %s", primaryKey, code, scope, table, rows[0].Payer, payer)
+	}
+}
+
+// checkPayers returns a message for every row not paid by one of payers
+func checkPayers(rows []TableRow, payers []string) []string {
+	var messages []string
+	for _, row := range rows {
+		found := false
+		for _, payer := range payers {
+			if row.Payer == payer {
+				found = true
+				break
+			}
+		}
+		if !found {
+			messages = append(messages, fmt.Sprintf("row %s is paid by %s", row.Data.ToString(), row.Payer))
+		}
+	}
+	return messages
+}
+
+// RequireTablePayers fails the test if a row of a table is not paid by one of payers
+func (p *ChainTester) RequireTablePayers(t TestingT, code string, scope string, table string, payers ...string) {
+	t.Helper()
+	rows, err := p.getAllTableRowsWithPayer(code, scope, table)
+	if err != nil {
+		t.Fatalf("get rows of %s %s %s: %v", code, scope, table, err)
+	}
+	for _, message := range checkPayers(rows, payers) {
+		t.Errorf("%s %s %s: %s, expected %s", code, scope, table, message, strings.Join(payers, " or "))
+	}
+}
@@ -0,0 +1,92 @@
+package chaintester
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewAccountInfo(t *testing.T) {
+	value := NewJsonValue([]byte(`{
+		"account_name": "alice",
+		"head_block_num": 12,
+		"privileged": false,
+		"ram_quota": 5462,
+		"net_weight": "10000",
+		"cpu_weight": 10000,
+		"net_limit": {"used": 120, "available": "18446744073709551", "max": 18446744073709551},
+		"cpu_limit": {"used": 300, "available": 4000, "max": 4300},
+		"ram_usage": 2996,
+		"permissions": []
+	}`))
+
+	info, err := NewAccountInfo(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.AccountName != "alice" || info.RAMQuota != 5462 || info.RAMUsage != 2996 || info.NetWeight != 10000 || info.CPUWeight != 10000 {
+		t.Errorf("bad account info: %+v", info)
+	}
+	if info.NetLimit.Used != 120 || info.NetLimit.Available != 18446744073709551 || info.CPULimit.Max != 4300 {
+		t.Errorf("bad limits: %+v %+v", info.NetLimit, info.CPULimit)
+	}
+	if info.AvailableRAM() != 5462-2996 {
+		t.Errorf("available RAM %d", info.AvailableRAM())
+	}
+
+	info.RAMQuota = -1
+	if info.AvailableRAM() != -1 {
+		t.Errorf("available RAM %d of unlimited account", info.AvailableRAM())
+	}
+
+	if _, err := NewAccountInfo(NewJsonValue([]byte(`{"ram_quota": 1}`))); err == nil {
+		t.Error("account info without name should fail")
+	}
+}
+
+func TestRAMDiff(t *testing.T) {
+	before := RAMSnapshot{"alice": 1000, "bob": 2000, "hello": 3000}
+	after := RAMSnapshot{"alice": 1240, "bob": 2000, "hello": 2890}
+
+	diff := before.Diff(after)
+	if len(diff) != 3 || diff["alice"] != 240 || diff["bob"] != 0 || diff["hello"] != -110 {
+		t.Errorf("diff %v", diff)
+	}
+	if s := diff.String(); s != "alice: +240, bob: +0, hello: -110" {
+		t.Errorf("diff %s", s)
+	}
+}
+
+func TestTableRowsWithPayer(t *testing.T) {
+	value := NewJsonValue([]byte(`{
+		"rows": [
+			{"data": {"key": 1, "count": 2}, "payer": "alice"},
+			{"data": {"key": 2, "count": 1}, "payer": "hello"}
+		],
+		"more": true,
+		"next_key": "3"
+	}`))
+
+	rows, more, nextKey, err := parseTableRowsWithPayer(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || !more || nextKey != "3" {
+		t.Fatalf("rows %v, more %v, next key %s", rows, more, nextKey)
+	}
+	if count, err := rows[0].Data.GetInt("count"); err != nil || count != 2 || rows[0].Payer != "alice" {
+		t.Errorf("row %v: %v", rows[0], err)
+	}
+
+	if messages := checkPayers(rows, []string{"alice", "hello"}); len(messages) != 0 {
+		t.Errorf("messages %q", messages)
+	}
+	messages := checkPayers(rows, []string{"alice"})
+	if len(messages) != 1 || !strings.Contains(messages[0], `"key": 2`) || !strings.HasSuffix(messages[0], "is paid by hello") {
+		t.Errorf("messages %q", messages)
+	}
+
+	// rows are returned without payers if show_payer is not set
+	if _, _, _, err := parseTableRowsWithPayer(NewJsonValue([]byte(`{"rows": [{"key": 1}], "more": false}`))); err == nil {
+		t.Error("rows without payer should fail")
+	}
+}
@@ -2,6 +2,7 @@ package chaintester
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/uuosio/chaintester/interfaces"
 )
@@ -9,10 +10,16 @@ import (
 type ActionSender struct {
 	tester  *ChainTester
 	actions []*interfaces.Action
+	// public keys added by AddSigningKey
+	keys []string
+
+	expectFailure bool
+	// assertion message expected by ExpectFailure
+	expectedMessage string
 }
 
 func NewActionSender(tester *ChainTester) *ActionSender {
-	return &ActionSender{tester, nil}
+	return &ActionSender{tester: tester}
 }
 
 func (sender *ActionSender) AddAction(account string, action string, args string, permissions string) {
@@ -51,6 +58,160 @@ func (sender *ActionSender) AddActionWithSignerEx(account string, action string,
 	sender.AddActionEx(account, action, rawArgs, permissions)
 }
 
+// AddActionWithAuth adds an action authorized by auth, an account may appear more than once
+// with different permissions, which requires a server accepting the list form of permissions
+func (sender *ActionSender) AddActionWithAuth(account string, action string, jsonArgs string, auth ...PermissionLevel) error {
+	permissions, err := encodePermissions(auth, false)
+	if err != nil {
+		return err
+	}
+	sender.AddAction(account, action, jsonArgs, permissions)
+	return nil
+}
+
+func (sender *ActionSender) AddActionWithAuthEx(account string, action string, rawArgs []byte, auth ...PermissionLevel) error {
+	permissions, err := encodePermissions(auth, false)
+	if err != nil {
+		return err
+	}
+	sender.AddActionEx(account, action, rawArgs, permissions)
+	return nil
+}
+
+// AddActionWithOrderedAuth adds an action authorized by auth in the ordered list form of permissions,
+// so the server keeps the order of auth, see AddActionWithAuth
+func (sender *ActionSender) AddActionWithOrderedAuth(account string, action string, jsonArgs string, auth ...PermissionLevel) error {
+	permissions, err := encodePermissions(auth, true)
+	if err != nil {
+		return err
+	}
+	sender.AddAction(account, action, jsonArgs, permissions)
+	return nil
+}
+
+// AddSigningKey imports a key pair with ImportKey so that the transaction
+// can be signed by it, the public key is used by GetRequiredKeys
+func (sender *ActionSender) AddSigningKey(pubKey string, privKey string) error {
+	if err := sender.tester.ImportKey(pubKey, privKey); err != nil {
+		return err
+	}
+	for _, key := range sender.keys {
+		if key == pubKey {
+			return nil
+		}
+	}
+	sender.keys = append(sender.keys, pubKey)
+	return nil
+}
+
+// Transaction returns a transaction with the queued actions
+func (sender *ActionSender) Transaction() *Transaction {
+	trx := NewTransaction()
+	trx.Actions = append(trx.Actions, sender.actions...)
+	return trx
+}
+
+// GetRequiredKeys returns the keys required to sign the queued actions,
+// keys added by AddSigningKey are used if availableKeys is empty
+func (sender *ActionSender) GetRequiredKeys(availableKeys ...string) ([]string, error) {
+	if len(availableKeys) == 0 {
+		availableKeys = sender.keys
+	}
+
+	trx, err := sender.tester.packTransaction(sender.Transaction())
+	if err != nil {
+		return nil, err
+	}
+	return sender.tester.GetRequiredKeys(trx, availableKeys)
+}
+
+// Len returns the number of queued actions
+func (sender *ActionSender) Len() int {
+	return len(sender.actions)
+}
+
+// Actions returns a copy of the queued actions
+func (sender *ActionSender) Actions() []*interfaces.Action {
+	return append([]*interfaces.Action{}, sender.actions...)
+}
+
+// Remove removes the queued action at index
+func (sender *ActionSender) Remove(index int) error {
+	if index < 0 || index >= len(sender.actions) {
+		return newErrorf("action index out of range: %d", index)
+	}
+	sender.actions = append(sender.actions[:index], sender.actions[index+1:]...)
+	return nil
+}
+
+// Reset removes the queued actions and the expected failure, signing keys are kept
+func (sender *ActionSender) Reset() {
+	sender.actions = nil
+	sender.expectFailure = false
+	sender.expectedMessage = ""
+}
+
+// ExpectFailure marks the batch as expected to fail. Send then succeeds only if the
+// transaction fails with an assertion message containing message, an empty message
+// matches any failure.
+func (sender *ActionSender) ExpectFailure(message string) *ActionSender {
+	sender.expectFailure = true
+	sender.expectedMessage = message
+	return sender
+}
+
+// Validate checks the names and permissions of the queued actions and packs their JSON arguments
+// with the ABI of their contracts, empty and raw arguments are not packed. Send does not validate
+// the actions, call Validate before Send to report invalid arguments before pushing.
+func (sender *ActionSender) Validate() error {
+	if len(sender.actions) == 0 {
+		return newErrorf("no actions to send")
+	}
+
+	for i, action := range sender.actions {
+		if err := checkName("account", action.Account); err != nil {
+			return newErrorf("action %d: %v", i, err)
+		}
+		if err := checkName("action", action.Action); err != nil {
+			return newErrorf("action %d: %v", i, err)
+		}
+		if _, err := decodePermissions(action.Permissions); err != nil {
+			return newErrorf("action %d %s::%s: %v", i, action.Account, action.Action, err)
+		}
+		if action.Arguments.IsSetRawArgs_() || action.Arguments.GetJSONArgs_() == "" {
+			continue
+		}
+		if _, err := sender.tester.packActionArgs(action); err != nil {
+			return newErrorf("action %d %s::%s: invalid arguments: %v", i, action.Account, action.Action, err)
+		}
+	}
+	return nil
+}
+
+// Send pushes the queued actions in one transaction, the actions are kept and can be
+// sent again. If the batch is expected to fail, the trace of the failed transaction
+// is returned.
 func (sender *ActionSender) Send() (*JsonValue, error) {
-	return sender.tester.PushActions(sender.actions)
+	ret, err := sender.tester.PushActions(sender.actions)
+	if !sender.expectFailure {
+		return ret, err
+	}
+
+	if err == nil {
+		return nil, newErrorf("transaction should fail with %q", sender.expectedMessage)
+	}
+	trxErr, ok := err.(*TransactionError)
+	if !ok {
+		return nil, err
+	}
+	if message := trxErr.AssertMessage(); !strings.Contains(message, sender.expectedMessage) {
+		return nil, newErrorf("transaction should fail with %q, got %q", sender.expectedMessage, message)
+	}
+	return trxErr.Json(), nil
+}
+
+// SendAndReset sends the queued actions and resets the sender whether the send succeeds or not
+func (sender *ActionSender) SendAndReset() (*JsonValue, error) {
+	defer sender.Reset()
+	return sender.Send()
 }
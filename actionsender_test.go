@@ -0,0 +1,73 @@
+package chaintester
+
+import "testing"
+
+func TestActionSender(t *testing.T) {
+	sender := NewActionSender(nil)
+	sender.AddActionWithSigner("hello", "inc", `{"name": "go"}`, "hello")
+	sender.AddActionWithSignerEx("hello", "test", []byte{}, "alice")
+	if err := sender.AddActionWithAuth("hello", "inc", "", PermissionLevel{"hello", "owner"}, PermissionLevel{"hello", "active"}); err != nil {
+		t.Fatal(err)
+	}
+	if sender.Len() != 3 {
+		t.Fatalf("bad length: %d", sender.Len())
+	}
+
+	actions := sender.Actions()
+	actions[0] = nil
+	if sender.Actions()[0] == nil {
+		t.Fatal("Actions should return a copy")
+	}
+
+	if err := sender.Remove(1); err != nil {
+		t.Fatal(err)
+	}
+	if sender.Len() != 2 || sender.Actions()[1].Permissions != `[{"actor":"hello","permission":"owner"},{"actor":"hello","permission":"active"}]` {
+		t.Fatalf("bad actions after remove: %v", sender.Actions())
+	}
+	for _, index := range []int{-1, 2} {
+		if err := sender.Remove(index); err == nil {
+			t.Errorf("remove %d should fail", index)
+		}
+	}
+
+	sender.ExpectFailure("overdrawn balance")
+	sender.Reset()
+	if sender.Len() != 0 || sender.expectFailure || sender.expectedMessage != "" {
+		t.Fatal("sender is not reset")
+	}
+	if err := sender.Validate(); err == nil {
+		t.Fatal("empty sender should be invalid")
+	}
+
+	sender.AddAction("Hello", "inc", "", `{"hello": "active"}`)
+	if err := sender.Validate(); err == nil {
+		t.Fatal("invalid account should be invalid")
+	}
+	sender.Reset()
+	// empty and raw arguments are not packed, so no tester is needed
+	sender.AddAction("hello", "inc", "", `{"hello": "active"}`)
+	sender.AddActionEx("hello", "inc", []byte{1}, `{"hello": "active"}`)
+	if err := sender.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	sender.Reset()
+	sender.AddActionEx("hello", "inc", []byte{}, `hello`)
+	if err := sender.Validate(); err == nil {
+		t.Fatal("invalid permissions should be invalid")
+	}
+}
+
+func TestAssertMessage(t *testing.T) {
+	trace := `{"except": {"code": 3050003, "name": "eosio_assert_message_exception", "message": "eosio_assert_message assertion failure",
+		"stack": [{"format": "assertion failure with message: ${s}", "data": {"s": "overdrawn balance"}}]}}`
+	if message := NewTransactionError([]byte(trace)).AssertMessage(); message != "overdrawn balance" {
+		t.Fatalf("bad assert message: %s", message)
+	}
+
+	trace = `{"except": {"code": 3090003, "name": "unsatisfied_authorization", "message": "Provided keys, permissions, and delays do not satisfy declared authorizations",
+		"stack": [{"format": "transaction declares authority '${auth}'", "data": {"auth": {"actor": "hello", "permission": "active"}}}]}}`
+	if message := NewTransactionError([]byte(trace)).AssertMessage(); message != "Provided keys, permissions, and delays do not satisfy declared authorizations" {
+		t.Fatalf("bad assert message: %s", message)
+	}
+}
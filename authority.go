@@ -0,0 +1,448 @@
+package chaintester
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/uuosio/chaintester/interfaces"
+	"github.com/uuosio/chaintester/keys"
+)
+
+type PermissionLevel struct {
+	Actor      string `json:"actor"`
+	Permission string `json:"permission"`
+}
+
+type KeyWeight struct {
+	Key    string `json:"key"`
+	Weight uint16 `json:"weight"`
+}
+
+type PermissionLevelWeight struct {
+	Permission PermissionLevel `json:"permission"`
+	Weight     uint16          `json:"weight"`
+}
+
+type WaitWeight struct {
+	WaitSec uint32 `json:"wait_sec"`
+	Weight  uint16 `json:"weight"`
+}
+
+type Authority struct {
+	Threshold uint32                  `json:"threshold"`
+	Keys      []KeyWeight             `json:"keys"`
+	Accounts  []PermissionLevelWeight `json:"accounts"`
+	Waits     []WaitWeight            `json:"waits"`
+}
+
+func NewAuthority(threshold uint32) *Authority {
+	return &Authority{
+		Threshold: threshold,
+		Keys:      []KeyWeight{},
+		Accounts:  []PermissionLevelWeight{},
+		Waits:     []WaitWeight{},
+	}
+}
+
+func (a *Authority) AddKey(key string, weight uint16) *Authority {
+	a.Keys = append(a.Keys, KeyWeight{key, weight})
+	return a
+}
+
+func (a *Authority) AddAccount(actor string, permission string, weight uint16) *Authority {
+	a.Accounts = append(a.Accounts, PermissionLevelWeight{PermissionLevel{actor, permission}, weight})
+	return a
+}
+
+func (a *Authority) AddWait(waitSec uint32, weight uint16) *Authority {
+	a.Waits = append(a.Waits, WaitWeight{waitSec, weight})
+	return a
+}
+
+func lessPermissionLevel(a PermissionLevel, b PermissionLevel) bool {
+	if a.Actor != b.Actor {
+		return S2N(a.Actor) < S2N(b.Actor)
+	}
+	return S2N(a.Permission) < S2N(b.Permission)
+}
+
+// packKey returns the key in the binary form the chain orders keys by, the key type followed by
+// the key data. Invalid keys are nil and are sorted first, Validate rejects them.
+func packKey(key string) []byte {
+	k, err := keys.ParsePublicKey(key)
+	if err != nil {
+		return nil
+	}
+	data, err := k.Pack()
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// Sort sorts keys, accounts and waits in the order required by the chain
+func (a *Authority) Sort() *Authority {
+	sort.SliceStable(a.Keys, func(i, j int) bool {
+		return bytes.Compare(packKey(a.Keys[i].Key), packKey(a.Keys[j].Key)) < 0
+	})
+	sort.SliceStable(a.Accounts, func(i, j int) bool {
+		return lessPermissionLevel(a.Accounts[i].Permission, a.Accounts[j].Permission)
+	})
+	sort.SliceStable(a.Waits, func(i, j int) bool {
+		return a.Waits[i].WaitSec < a.Waits[j].WaitSec
+	})
+	return a
+}
+
+// Validate checks the authority the same way the chain does before it is pushed,
+// keys, accounts and waits must be sorted like Sort does.
+func (a *Authority) Validate() error {
+	if a.Threshold == 0 {
+		return newErrorf("authority threshold must be greater than zero")
+	}
+
+	var totalWeight uint64
+	var prev []byte
+	for _, k := range a.Keys {
+		if k.Key == "" {
+			return newErrorf("empty key in authority")
+		}
+		// the same key can be written in the legacy and the PUB_K1_ format
+		key, err := keys.ParsePublicKey(k.Key)
+		if err != nil {
+			return newErrorf("invalid key in authority: %v", err)
+		}
+		packed, err := key.Pack()
+		if err != nil {
+			return newErrorf("invalid key in authority: %v", err)
+		}
+		if prev != nil {
+			switch bytes.Compare(prev, packed) {
+			case 0:
+				return newErrorf("duplicated key in authority: %s", k.Key)
+			case 1:
+				return newErrorf("keys in authority are not sorted: %s", k.Key)
+			}
+		}
+		if k.Weight == 0 {
+			return newErrorf("weight of key %s must be greater than zero", k.Key)
+		}
+		prev = packed
+		totalWeight += uint64(k.Weight)
+	}
+
+	for i, account := range a.Accounts {
+		level := account.Permission
+		if level.Actor == "" || !IsValidName(level.Actor) || level.Permission == "" || !IsValidName(level.Permission) {
+			return newErrorf("invalid permission level in authority: %v", level)
+		}
+		if i > 0 && !lessPermissionLevel(a.Accounts[i-1].Permission, level) {
+			return newErrorf("accounts in authority are not sorted or duplicated: %v", level)
+		}
+		if account.Weight == 0 {
+			return newErrorf("weight of %v must be greater than zero", level)
+		}
+		totalWeight += uint64(account.Weight)
+	}
+
+	for i, wait := range a.Waits {
+		if wait.WaitSec == 0 {
+			return newErrorf("wait_sec in authority must be greater than zero")
+		}
+		if i > 0 && a.Waits[i-1].WaitSec > wait.WaitSec {
+			return newErrorf("waits in authority are not sorted")
+		}
+		if wait.Weight == 0 {
+			return newErrorf("weight of wait %d must be greater than zero", wait.WaitSec)
+		}
+		totalWeight += uint64(wait.Weight)
+	}
+
+	if totalWeight < uint64(a.Threshold) {
+		return newErrorf("authority threshold %d can not be satisfied by total weight %d", a.Threshold, totalWeight)
+	}
+	return nil
+}
+
+func checkName(kind string, name string) error {
+	if name == "" || !IsValidName(name) {
+		return newErrorf("invalid %s: %q", kind, name)
+	}
+	return nil
+}
+
+// encodePermissions encodes authorizations of an action in the JSON object form {"actor": "permission"},
+// which is written in the order of auth. The ordered list form [{"actor": ..., "permission": ...}] is
+// used if an actor appears more than once or ordered is true. The list form needs a chaintester server
+// which also decodes permissions as a JSON list, servers which only decode the object form reject it.
+func encodePermissions(auth []PermissionLevel, ordered bool) (string, error) {
+	if len(auth) == 0 {
+		return "", newErrorf("action has no authorization")
+	}
+	actors := make(map[string]bool, len(auth))
+	for _, level := range auth {
+		if err := checkName("actor", level.Actor); err != nil {
+			return "", err
+		}
+		if err := checkName("permission", level.Permission); err != nil {
+			return "", err
+		}
+		if actors[level.Actor] {
+			ordered = true
+		}
+		actors[level.Actor] = true
+	}
+
+	if ordered {
+		data, err := json.Marshal(auth)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+
+	var b strings.Builder
+	b.WriteString("{")
+	for i, level := range auth {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, "%q:%q", level.Actor, level.Permission)
+	}
+	b.WriteString("}")
+	return b.String(), nil
+}
+
+// decodePermissions decodes permissions in either the JSON object form
+// {"actor": "permission"} or the ordered list form of encodePermissions,
+// the order of the object form is kept
+func decodePermissions(permissions string) ([]PermissionLevel, error) {
+	var auth []PermissionLevel
+	if err := json.Unmarshal([]byte(permissions), &auth); err == nil {
+		return auth, nil
+	}
+
+	var levels map[string]string
+	if err := json.Unmarshal([]byte(permissions), &levels); err != nil {
+		return nil, newErrorf("invalid permissions: %s", permissions)
+	}
+	decoder := json.NewDecoder(strings.NewReader(permissions))
+	auth = make([]PermissionLevel, 0, len(levels))
+	// skip the opening brace, keys and values alternate until the closing brace
+	if _, err := decoder.Token(); err != nil {
+		return nil, newErrorf("invalid permissions: %s", permissions)
+	}
+	for decoder.More() {
+		actor, err := decoder.Token()
+		if err != nil {
+			return nil, newErrorf("invalid permissions: %s", permissions)
+		}
+		var permission string
+		if err := decoder.Decode(&permission); err != nil {
+			return nil, newErrorf("invalid permissions: %s", permissions)
+		}
+		auth = append(auth, PermissionLevel{actor.(string), permission})
+	}
+	return auth, nil
+}
+
+func newAction(account string, action string, args interface{}, auth ...PermissionLevel) (*interfaces.Action, error) {
+	jsonArgs, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := encodePermissions(auth, false)
+	if err != nil {
+		return nil, err
+	}
+
+	_args := interfaces.NewActionArguments()
+	_jsonArgs := string(jsonArgs)
+	_args.JSONArgs_ = &_jsonArgs
+	return &interfaces.Action{
+		Account:     account,
+		Action:      action,
+		Arguments:   _args,
+		Permissions: permissions,
+	}, nil
+}
+
+func updateAuthAction(account string, permission string, parent string, auth *Authority) (*interfaces.Action, error) {
+	if err := checkName("account", account); err != nil {
+		return nil, err
+	}
+	if err := checkName("permission", permission); err != nil {
+		return nil, err
+	}
+	if permission == "owner" {
+		if parent != "" {
+			return nil, newErrorf("owner permission can not have a parent")
+		}
+	} else if err := checkName("parent", parent); err != nil {
+		return nil, err
+	}
+	if auth == nil {
+		return nil, newErrorf("authority is nil")
+	}
+	// the chain does not accept null for empty lists, the authority of the caller is left unchanged
+	copied := *auth
+	auth = &copied
+	if auth.Keys == nil {
+		auth.Keys = []KeyWeight{}
+	}
+	if auth.Accounts == nil {
+		auth.Accounts = []PermissionLevelWeight{}
+	}
+	if auth.Waits == nil {
+		auth.Waits = []WaitWeight{}
+	}
+	if err := auth.Validate(); err != nil {
+		return nil, err
+	}
+
+	signer := "active"
+	if permission == "owner" {
+		signer = "owner"
+	}
+	args := map[string]interface{}{
+		"account":    account,
+		"permission": permission,
+		"parent":     parent,
+		"auth":       auth,
+	}
+	return newAction("eosio", "updateauth", args, PermissionLevel{account, signer})
+}
+
+func (p *ChainTester) pushAuthAction(action *interfaces.Action, err error) (*JsonValue, error) {
+	if err != nil {
+		return nil, err
+	}
+	return p.PushActions([]*interfaces.Action{action})
+}
+
+// UpdateAuth creates or updates permission of account, parent must be empty for the owner permission
+func (p *ChainTester) UpdateAuth(account string, permission string, parent string, auth *Authority) (*JsonValue, error) {
+	return p.pushAuthAction(updateAuthAction(account, permission, parent, auth))
+}
+
+func (p *ChainTester) DeleteAuth(account string, permission string) (*JsonValue, error) {
+	if err := checkName("account", account); err != nil {
+		return nil, err
+	}
+	if err := checkName("permission", permission); err != nil {
+		return nil, err
+	}
+	if permission == "owner" || permission == "active" {
+		return nil, newErrorf("can not delete %s permission", permission)
+	}
+
+	args := map[string]string{
+		"account":    account,
+		"permission": permission,
+	}
+	return p.pushAuthAction(newAction("eosio", "deleteauth", args, PermissionLevel{account, "active"}))
+}
+
+// LinkAuth requires permission requirement of account to call action type of contract code,
+// an empty type links all the actions of code
+func (p *ChainTester) LinkAuth(account string, code string, _type string, requirement string) (*JsonValue, error) {
+	if err := checkName("account", account); err != nil {
+		return nil, err
+	}
+	if err := checkName("code", code); err != nil {
+		return nil, err
+	}
+	if !IsValidName(_type) {
+		return nil, newErrorf("invalid type: %q", _type)
+	}
+	if err := checkName("requirement", requirement); err != nil {
+		return nil, err
+	}
+
+	args := map[string]string{
+		"account":     account,
+		"code":        code,
+		"type":        _type,
+		"requirement": requirement,
+	}
+	return p.pushAuthAction(newAction("eosio", "linkauth", args, PermissionLevel{account, "active"}))
+}
+
+func (p *ChainTester) UnlinkAuth(account string, code string, _type string) (*JsonValue, error) {
+	if err := checkName("account", account); err != nil {
+		return nil, err
+	}
+	if err := checkName("code", code); err != nil {
+		return nil, err
+	}
+	if !IsValidName(_type) {
+		return nil, newErrorf("invalid type: %q", _type)
+	}
+
+	args := map[string]string{
+		"account": account,
+		"code":    code,
+		"type":    _type,
+	}
+	return p.pushAuthAction(newAction("eosio", "unlinkauth", args, PermissionLevel{account, "active"}))
+}
+
+type accountPermission struct {
+	PermName     string    `json:"perm_name"`
+	Parent       string    `json:"parent"`
+	RequiredAuth Authority `json:"required_auth"`
+}
+
+// GetPermission returns the authority and the parent of a permission of account
+func (p *ChainTester) GetPermission(account string, permission string) (*Authority, string, error) {
+	info, err := p.GetAccount(account)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var accountInfo struct {
+		Permissions []accountPermission `json:"permissions"`
+	}
+	if err := json.Unmarshal(info.raw, &accountInfo); err != nil {
+		return nil, "", newError(err)
+	}
+
+	for _, perm := range accountInfo.Permissions {
+		if perm.PermName == permission {
+			auth := perm.RequiredAuth
+			return &auth, perm.Parent, nil
+		}
+	}
+	return nil, "", newErrorf("permission %s@%s not found", account, permission)
+}
+
+// AddCodePermission adds account@eosio.code to the active permission of account,
+// so that the contract deployed to account can send inline actions with it
+func (p *ChainTester) AddCodePermission(account string) (*JsonValue, error) {
+	auth, parent, err := p.GetPermission(account, "active")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range auth.Accounts {
+		if a.Permission.Actor == account && a.Permission.Permission == "eosio.code" {
+			return nil, newErrorf("%s@eosio.code already exists in %s@active", account, account)
+		}
+	}
+
+	// the weight of eosio.code satisfies the threshold by itself
+	if auth.Threshold > math.MaxUint16 {
+		return nil, newErrorf("threshold %d of %s@active exceeds the max weight of eosio.code", auth.Threshold, account)
+	}
+	auth.AddAccount(account, "eosio.code", uint16(auth.Threshold)).Sort()
+	return p.UpdateAuth(account, "active", parent, auth)
+}
+
+func (level PermissionLevel) String() string {
+	return fmt.Sprintf("%s@%s", level.Actor, level.Permission)
+}
@@ -0,0 +1,177 @@
+package chaintester
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/uuosio/chaintester/keys"
+)
+
+func TestAuthority(t *testing.T) {
+	auth := NewAuthority(2).
+		AddKey("EOS6AjF6hvF7GSuSd4sCgfPKq5uWaXvGM2aQtEUCwmEHygQaqxBSV", 1).
+		AddAccount("hello", "eosio.code", 1).
+		AddAccount("alice", "active", 1).
+		AddWait(60, 1)
+
+	if err := auth.Validate(); err == nil {
+		t.Fatal("unsorted accounts should be invalid")
+	}
+
+	if err := auth.Sort().Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if auth.Accounts[0].Permission.Actor != "alice" {
+		t.Fatalf("accounts are not sorted: %+v", auth.Accounts)
+	}
+
+	data, err := json.Marshal(auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"threshold":2,"keys":[{"key":"EOS6AjF6hvF7GSuSd4sCgfPKq5uWaXvGM2aQtEUCwmEHygQaqxBSV","weight":1}],"accounts":[{"permission":{"actor":"alice","permission":"active"},"weight":1},{"permission":{"actor":"hello","permission":"eosio.code"},"weight":1}],"waits":[{"wait_sec":60,"weight":1}]}`
+	if string(data) != expected {
+		t.Fatalf("bad authority json: %s", data)
+	}
+
+	key, err := keys.ParsePublicKey("EOS6AjF6hvF7GSuSd4sCgfPKq5uWaXvGM2aQtEUCwmEHygQaqxBSV")
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalid := []*Authority{
+		NewAuthority(0).AddKey("EOS6AjF6hvF7GSuSd4sCgfPKq5uWaXvGM2aQtEUCwmEHygQaqxBSV", 1),
+		NewAuthority(3).AddKey("EOS6AjF6hvF7GSuSd4sCgfPKq5uWaXvGM2aQtEUCwmEHygQaqxBSV", 1),
+		NewAuthority(1).AddKey("EOS6AjF6hvF7GSuSd4sCgfPKq5uWaXvGM2aQtEUCwmEHygQaqxBSV", 1).AddKey("EOS6AjF6hvF7GSuSd4sCgfPKq5uWaXvGM2aQtEUCwmEHygQaqxBSV", 1),
+		NewAuthority(1).AddAccount("Hello", "active", 1),
+		NewAuthority(1).AddAccount("hello", "active", 0),
+		NewAuthority(1).AddAccount("hello", "active", 1).AddAccount("hello", "active", 1),
+		NewAuthority(1).AddWait(0, 1),
+		NewAuthority(1).AddKey("EOS6AjF6hvF7GSuSd4sCgfPKq5uWaXvGM2aQtEUCwmEHygQaqxBSW", 1),
+		NewAuthority(1).AddKey("EOS6AjF6hvF7GSuSd4sCgfPKq5uWaXvGM2aQtEUCwmEHygQaqxBSV", 1).AddKey(key.String(), 1),
+	}
+	for i, auth := range invalid {
+		if err := auth.Validate(); err == nil {
+			t.Errorf("authority %d should be invalid", i)
+		}
+	}
+
+	if _, err := updateAuthAction("hello", "owner", "active", NewAuthority(1).AddAccount("alice", "active", 1)); err == nil {
+		t.Error("owner permission with parent should be invalid")
+	}
+	if _, err := updateAuthAction("hello", "active", "owner", nil); err == nil {
+		t.Error("nil authority should be invalid")
+	}
+
+	auth = &Authority{Threshold: 1, Accounts: []PermissionLevelWeight{{PermissionLevel{"alice", "active"}, 1}}}
+	if _, err := updateAuthAction("hello", "active", "owner", auth); err != nil {
+		t.Fatal(err)
+	}
+	if auth.Keys != nil || auth.Waits != nil {
+		t.Errorf("authority of the caller is changed: %+v", auth)
+	}
+}
+
+func TestSortKeys(t *testing.T) {
+	var pubKeys []string
+	for _, keyType := range []keys.KeyType{keys.R1, keys.K1, keys.K1, keys.K1} {
+		key, err := keys.NewPrivateKey(keyType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKeys = append(pubKeys, key.PublicKey().LegacyString())
+	}
+
+	auth := NewAuthority(1)
+	for _, key := range pubKeys {
+		auth.AddKey(key, 1)
+	}
+	if err := auth.Validate(); err == nil || !strings.Contains(err.Error(), "not sorted") {
+		t.Fatalf("keys of different types in the wrong order: %v", err)
+	}
+	if err := auth.Sort().Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if auth.Keys[3].Key != pubKeys[0] {
+		t.Errorf("R1 key is not sorted after K1 keys: %v", auth.Keys)
+	}
+	for i := 1; i < len(auth.Keys); i++ {
+		if bytes.Compare(packKey(auth.Keys[i-1].Key), packKey(auth.Keys[i].Key)) >= 0 {
+			t.Errorf("keys are not sorted: %v", auth.Keys)
+		}
+	}
+}
+
+func TestPermissions(t *testing.T) {
+	auth := []PermissionLevel{{"hello", "owner"}, {"alice", "active"}}
+	for _, ordered := range []bool{false, true} {
+		permissions, err := encodePermissions(auth, ordered)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := `{"hello":"owner","alice":"active"}`
+		if ordered {
+			expected = `[{"actor":"hello","permission":"owner"},{"actor":"alice","permission":"active"}]`
+		}
+		if permissions != expected {
+			t.Fatalf("bad permissions: %s", permissions)
+		}
+		decoded, err := decodePermissions(permissions)
+		if err != nil || !reflect.DeepEqual(decoded, auth) {
+			t.Fatalf("order of permissions is not kept: %v, %v", decoded, err)
+		}
+	}
+
+	auth = []PermissionLevel{{"hello", "owner"}, {"hello", "active"}, {"alice", "active"}}
+	permissions, err := encodePermissions(auth, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `[{"actor":"hello","permission":"owner"},{"actor":"hello","permission":"active"},{"actor":"alice","permission":"active"}]`
+	if permissions != expected {
+		t.Fatalf("bad permissions: %s", permissions)
+	}
+
+	decoded, err := decodePermissions(permissions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, auth) {
+		t.Fatalf("order of permissions is not kept: %v", decoded)
+	}
+
+	decoded, err = decodePermissions(`{"hello": "active", "alice": "owner"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, []PermissionLevel{{"hello", "active"}, {"alice", "owner"}}) {
+		t.Fatalf("bad permissions: %v", decoded)
+	}
+
+	for _, auth := range [][]PermissionLevel{nil, {{"hello", ""}}, {{"Hello", "active"}}} {
+		if _, err := encodePermissions(auth, false); err == nil {
+			t.Errorf("permissions %v should be invalid", auth)
+		}
+	}
+	if _, err := decodePermissions(`"hello"`); err == nil {
+		t.Error("invalid permissions should fail to decode")
+	}
+}
+
+func TestParseRequiredKeys(t *testing.T) {
+	key := "EOS6AjF6hvF7GSuSd4sCgfPKq5uWaXvGM2aQtEUCwmEHygQaqxBSV"
+	for _, ret := range []string{`["` + key + `"]`, `{"required_keys": ["` + key + `"]}`} {
+		keys, err := parseRequiredKeys(ret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keys) != 1 || keys[0] != key {
+			t.Fatalf("bad required keys: %v", keys)
+		}
+	}
+	if _, err := parseRequiredKeys(`{"except": "unsatisfied authorization"}`); err == nil {
+		t.Error("error result should fail to parse")
+	}
+}
@@ -1,9 +1,15 @@
 package chaintester
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/uuosio/chaintester/keys"
 )
 
 var ctx = context.Background()
@@ -125,3 +131,452 @@ func native_apply(receiver uint64, firstReceiver uint64, action uint64) {
 		GetVMAPI().Prints(ctx, "hello, world!\n")
 	}
 }
+
+func TestSnapshot(t *testing.T) {
+	tester := NewChainTester()
+	defer tester.FreeChain()
+
+	err := tester.DeployContract("hello", "test/test.wasm", "test/test.abi")
+	if err != nil {
+		panic(err)
+	}
+	tester.ProduceBlock()
+
+	snapshot, err := tester.Snapshot()
+	if err != nil {
+		panic(err)
+	}
+	defer tester.FreeSnapshot(snapshot)
+
+	permissions := `
+	{
+		"hello": "active"
+	}
+	`
+	_, err = tester.PushAction("hello", "inc", "", permissions)
+	if err != nil {
+		panic(err)
+	}
+
+	fork, err := tester.Fork()
+	if err != nil {
+		panic(err)
+	}
+	defer fork.FreeChain()
+
+	err = tester.Restore(snapshot)
+	if err != nil {
+		panic(err)
+	}
+
+	rows, err := tester.GetTableRows(true, "hello", "", "counter", "", "", 10)
+	if err != nil {
+		panic(err)
+	}
+	if _, err := rows.Get("rows", 0); err == nil {
+		t.Fatalf("counter should be empty after restore: %s", rows.ToString())
+	}
+
+	rows, err = fork.GetTableRows(true, "hello", "", "counter", "", "", 10)
+	if err != nil {
+		panic(err)
+	}
+	if _, err := rows.Get("rows", 0); err != nil {
+		t.Fatalf("counter should be kept by fork: %s", rows.ToString())
+	}
+}
+
+func TestSession(t *testing.T) {
+	tester := NewChainTester()
+	defer tester.FreeChain()
+
+	err := tester.DeployContract("hello", "test/test.wasm", "test/test.abi")
+	if err != nil {
+		panic(err)
+	}
+	tester.ProduceBlock()
+
+	permissions := `
+	{
+		"hello": "active"
+	}
+	`
+	for i := 0; i < 2; i++ {
+		tester.Run(t, fmt.Sprintf("inc%d", i), func(t *testing.T) {
+			_, err := tester.PushAction("hello", "inc", "", permissions)
+			if err != nil {
+				panic(err)
+			}
+			if err := tester.ProduceBlock(); err == nil {
+				t.Fatal("produce block in undo session should fail")
+			}
+
+			rows, err := tester.GetTableRows(true, "hello", "", "counter", "", "", 10)
+			if err != nil {
+				panic(err)
+			}
+			count, err := rows.GetString("rows", 0, "count")
+			if err != nil {
+				panic(err)
+			}
+			if count != "1" {
+				t.Fatalf("counter should start from scratch in every subtest, got %s", count)
+			}
+		})
+	}
+}
+
+func TestMultisig(t *testing.T) {
+	tester := NewChainTester()
+	defer tester.FreeChain()
+
+	err := tester.DeployContract("hello", "test/test.wasm", "test/test.abi")
+	if err != nil {
+		panic(err)
+	}
+	tester.ProduceBlock()
+
+	keys := make([][2]string, 2)
+	for i := range keys {
+		key, err := tester.CreateKey()
+		if err != nil {
+			panic(err)
+		}
+		keys[i][0], _ = key.GetString("public")
+		keys[i][1], _ = key.GetString("private")
+	}
+
+	_, err = tester.CreateAccount("eosio", "multisig", keys[0][0], keys[0][0], 10*1024*1024)
+	if err != nil {
+		panic(err)
+	}
+
+	auth := NewAuthority(2).AddKey(keys[0][0], 1).AddKey(keys[1][0], 1)
+	_, err = tester.UpdateAuth("multisig", "active", "owner", auth.Sort())
+	if err != nil {
+		panic(err)
+	}
+
+	sender := NewActionSender(tester)
+	for _, key := range keys {
+		if err := sender.AddSigningKey(key[0], key[1]); err != nil {
+			panic(err)
+		}
+	}
+	err = sender.AddActionWithAuth("hello", "inc", "", PermissionLevel{"multisig", "active"})
+	if err != nil {
+		panic(err)
+	}
+
+	requiredKeys, err := sender.GetRequiredKeys()
+	if err != nil {
+		panic(err)
+	}
+	if len(requiredKeys) != 2 {
+		t.Fatalf("both keys of multisig@active should be required: %v", requiredKeys)
+	}
+
+	if _, err := sender.Send(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTwoAuthorizations(t *testing.T) {
+	tester := NewChainTester()
+	defer tester.FreeChain()
+
+	err := tester.DeployContract("hello", "test/test.wasm", "test/test.abi")
+	if err != nil {
+		panic(err)
+	}
+	tester.ProduceBlock()
+
+	sender := NewActionSender(tester)
+	err = sender.AddActionWithAuth("hello", "inc", "", PermissionLevel{"hello", "active"}, PermissionLevel{"alice", "active"})
+	if err != nil {
+		panic(err)
+	}
+	err = sender.AddActionWithAuth("hello", "inc", "", PermissionLevel{"hello", "owner"}, PermissionLevel{"hello", "active"})
+	if err != nil {
+		panic(err)
+	}
+	ret, err := sender.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, expected := range [][]PermissionLevel{{{"hello", "active"}, {"alice", "active"}}, {{"hello", "owner"}, {"hello", "active"}}} {
+		var auth []PermissionLevel
+		if err := ret.Unmarshal(&auth, "action_traces", i, "act", "authorization"); err != nil {
+			t.Fatal(err)
+		}
+		if fmt.Sprint(auth) != fmt.Sprint(expected) {
+			t.Errorf("authorization of action %d: %v, expected %v", i, auth, expected)
+		}
+	}
+}
+
+func TestPushTransaction(t *testing.T) {
+	tester := NewChainTester()
+	defer tester.FreeChain()
+
+	err := tester.DeployContract("hello", "test/test.wasm", "test/test.abi")
+	if err != nil {
+		panic(err)
+	}
+	tester.ProduceBlock()
+
+	trx := NewTransaction().
+		AddAction("hello", "inc", "", PermissionLevel{"hello", "active"}).
+		AddContextFreeData([]byte("hello"))
+	ret, err := tester.PushTransaction(trx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.ID == "" || ret.Scheduled {
+		t.Fatalf("bad transaction result: %+v", ret)
+	}
+
+	trx = NewTransaction().
+		SetDelay(time.Second).
+		AddAction("hello", "inc", "", PermissionLevel{"hello", "active"})
+	ret, err = tester.PushTransaction(trx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ret.Scheduled {
+		t.Fatalf("transaction with delay should be scheduled: %s", ret.Trace.ToString())
+	}
+}
+
+func TestDeferred(t *testing.T) {
+	tester := NewChainTester()
+	defer tester.FreeChain()
+
+	err := tester.DeployContract("hello", "test/test.wasm", "test/test.abi")
+	if err != nil {
+		panic(err)
+	}
+	tester.ProduceBlock()
+
+	for i := 0; i < 2; i++ {
+		trx := NewTransaction().
+			SetDelay(time.Duration(i+1)*10*time.Second).
+			AddAction("hello", "inc", "", PermissionLevel{"hello", "active"})
+		if _, err := tester.PushTransaction(trx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deferred, err := tester.ListDeferred()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deferred) != 2 || deferred[0].Payer != "hello" {
+		t.Fatalf("bad deferred transactions: %+v", deferred)
+	}
+
+	if _, err := tester.ExecuteDeferred(deferred[1].TrxID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tester.ProduceBlockUntilDeferredDrained(); err != nil {
+		t.Fatal(err)
+	}
+	deferred, err = tester.ListDeferred()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deferred) != 0 {
+		t.Fatalf("deferred transactions should be drained: %+v", deferred)
+	}
+
+	rows, err := tester.GetTableRows(true, "hello", "", "counter", "", "", 10)
+	if err != nil {
+		panic(err)
+	}
+	count, err := rows.GetString("rows", 0, "count")
+	if err != nil {
+		panic(err)
+	}
+	if count != "2" {
+		t.Fatalf("both deferred transactions should be executed, got %s", count)
+	}
+}
+
+func TestPushSignedTransaction(t *testing.T) {
+	tester := NewChainTester()
+	defer tester.FreeChain()
+
+	err := tester.DeployContract("hello", "test/test.wasm", "test/test.abi")
+	if err != nil {
+		panic(err)
+	}
+	tester.ProduceBlock()
+
+	if err := tester.SetAutoSign(false); err != nil {
+		panic(err)
+	}
+	defer tester.SetAutoSign(true)
+
+	trx := NewTransaction().AddAction("hello", "inc", "", PermissionLevel{"hello", "active"})
+	if _, err := tester.PushTransaction(trx); err == nil {
+		t.Fatal("transaction without signatures should fail")
+	}
+
+	if _, err := tester.KeyStore().Import(testPrivateKey); err != nil {
+		panic(err)
+	}
+	signed, err := tester.SignTransaction(trx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ret, err := tester.PushSignedTransaction(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.ID != signed.ID {
+		t.Fatalf("bad transaction id: %s != %s", ret.ID, signed.ID)
+	}
+
+	// a signed transaction which is not produced by SignTransaction, the new block changes its TAPOS
+	tester.ProduceBlock()
+	trx = NewTransaction().AddAction("hello", "inc", "", PermissionLevel{"hello", "active"})
+	signed, err = tester.SignTransaction(trx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &SignedTransaction{
+		ID:         signed.ID,
+		PackedTrx:  signed.PackedTrx,
+		Signatures: signed.Signatures,
+		Digest:     signed.Digest,
+	}
+	ret, err = tester.PushSignedTransaction(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.ID != decoded.ID {
+		t.Fatalf("bad transaction id: %s != %s", ret.ID, decoded.ID)
+	}
+}
+
+func TestKeys(t *testing.T) {
+	tester := NewChainTester()
+	defer tester.FreeChain()
+
+	for _, keyType := range []keys.KeyType{keys.K1, keys.R1} {
+		key, err := tester.CreateKey(string(keyType))
+		if err != nil {
+			panic(err)
+		}
+		privKey, _ := key.GetString("private")
+		pubKey, _ := key.GetString("public")
+
+		priv, err := keys.ParsePrivateKey(privKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pub, err := keys.ParsePublicKey(pubKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !priv.PublicKey().Equal(pub) {
+			t.Fatalf("public key of %s is %s, not %s", privKey, priv.PublicKey(), pubKey)
+		}
+	}
+
+	key, err := keys.NewPrivateKey(keys.K1)
+	if err != nil {
+		panic(err)
+	}
+	digest := sha256.Sum256([]byte("hello"))
+	sig, err := key.Sign(digest[:])
+	if err != nil {
+		panic(err)
+	}
+	packedSig, _ := sig.Pack()
+	packedPub, _ := key.PublicKey().Pack()
+
+	var recovered []byte
+	tester.SetNativeApply("hello", func(receiver uint64, firstReceiver uint64, action uint64) {
+		recovered, err = GetVMAPI().RecoverKey(ctx, digest[:], packedSig)
+		if err != nil {
+			panic(err)
+		}
+	})
+	defer tester.SetNativeApply("hello", nil)
+
+	_, err = tester.PushAction("hello", "inc", "", `{"hello": "active"}`)
+	if err != nil {
+		panic(err)
+	}
+	if !bytes.Equal(recovered, packedPub) {
+		t.Fatalf("bad recovered key: %x != %x", recovered, packedPub)
+	}
+}
+
+func TestActionSenderExpectFailure(t *testing.T) {
+	tester := NewChainTester()
+	defer tester.FreeChain()
+
+	err := tester.DeployContract("hello", "test/test.wasm", "test/test.abi")
+	if err != nil {
+		panic(err)
+	}
+	tester.ProduceBlock()
+
+	sender := NewActionSender(tester)
+	sender.AddActionWithSigner("hello", "inc", `{"name": 1, "unknown": true}`, "hello")
+	if err := sender.Validate(); err == nil {
+		t.Fatal("invalid arguments should fail to validate")
+	}
+	sender.Reset()
+
+	sender.AddActionWithSigner("hello", "inc", `{"name": "go"}`, "hello")
+	if _, err := sender.SendAndReset(); err != nil {
+		t.Fatal(err)
+	}
+	if sender.Len() != 0 {
+		t.Fatal("actions should be reset")
+	}
+
+	sender.AddActionWithSigner("hello", "inc", `{"name": "go"}`, "hello")
+	sender.AddActionWithSigner("hello", "inc", `{"name": "go"}`, "alice")
+	if _, err := sender.ExpectFailure("").SendAndReset(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChainOptionsGenesis(t *testing.T) {
+	if _, err := NewChainTesterWithOptions(ChainOptions{Contracts: BareChain, ChainID: strings.Repeat("00", 32)}); err == nil {
+		t.Fatal("mismatched chain id should fail")
+	}
+
+	key, err := keys.NewPrivateKey(keys.K1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	initialTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	tester, err := NewChainTesterWithOptions(ChainOptions{Contracts: BareChain, GenesisKey: key.String(), InitialTime: initialTime})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tester.FreeChain()
+
+	info, err := tester.GetChainInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.HeadBlockTime.Before(initialTime) {
+		t.Fatalf("head block time %v is before initial time %v", info.HeadBlockTime, initialTime)
+	}
+
+	// eosio signs with the genesis key
+	if _, err := tester.CreateAccount("eosio", "alice", DefaultGenesisKey, DefaultGenesisKey, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := tester.KeyStore().Signer(key.PublicKey().String()); !ok {
+		t.Fatal("genesis key should be in the key store")
+	}
+}
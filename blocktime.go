@@ -0,0 +1,175 @@
+package chaintester
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/uuosio/chaintester/interfaces"
+)
+
+// BlockInterval is the time between two blocks
+const BlockInterval = 500 * time.Millisecond
+
+type ChainInfo struct {
+	ServerVersion            string
+	ChainID                  string
+	HeadBlockNum             uint32
+	LastIrreversibleBlockNum uint32
+	LastIrreversibleBlockID  string
+	HeadBlockID              string
+	HeadBlockTime            time.Time
+	HeadBlockProducer        string
+	VirtualBlockCPULimit     uint64
+	VirtualBlockNetLimit     uint64
+	BlockCPULimit            uint64
+	BlockNetLimit            uint64
+}
+
+func getUint(value *JsonValue, bitSize int, key string) (uint64, error) {
+	s, err := value.GetString(key)
+	if err != nil {
+		return 0, newErrorf("%s: %v", key, err)
+	}
+	n, err := strconv.ParseUint(s, 10, bitSize)
+	if err != nil {
+		return 0, newErrorf("%s: %v", key, err)
+	}
+	return n, nil
+}
+
+func NewChainInfo(value *JsonValue) (*ChainInfo, error) {
+	var err error
+	info := &ChainInfo{}
+
+	fields := []struct {
+		key   string
+		value *string
+	}{
+		{"server_version", &info.ServerVersion},
+		{"chain_id", &info.ChainID},
+		{"last_irreversible_block_id", &info.LastIrreversibleBlockID},
+		{"head_block_id", &info.HeadBlockID},
+		{"head_block_producer", &info.HeadBlockProducer},
+	}
+	for _, s := range fields {
+		*s.value, err = value.GetString(s.key)
+		if err != nil {
+			return nil, newErrorf("%s: %v", s.key, err)
+		}
+	}
+
+	headBlockNum, err := getUint(value, 32, "head_block_num")
+	if err != nil {
+		return nil, err
+	}
+	info.HeadBlockNum = uint32(headBlockNum)
+
+	lastIrreversibleBlockNum, err := getUint(value, 32, "last_irreversible_block_num")
+	if err != nil {
+		return nil, err
+	}
+	info.LastIrreversibleBlockNum = uint32(lastIrreversibleBlockNum)
+
+	limits := []struct {
+		key   string
+		value *uint64
+	}{
+		{"virtual_block_cpu_limit", &info.VirtualBlockCPULimit},
+		{"virtual_block_net_limit", &info.VirtualBlockNetLimit},
+		{"block_cpu_limit", &info.BlockCPULimit},
+		{"block_net_limit", &info.BlockNetLimit},
+	}
+	for _, limit := range limits {
+		*limit.value, err = getUint(value, 64, limit.key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	headBlockTime, err := value.GetTime("head_block_time")
+	if err != nil {
+		return nil, newErrorf("head_block_time: %v", err)
+	}
+	info.HeadBlockTime = *headBlockTime
+	return info, nil
+}
+
+// GetChainInfo returns the result of GetInfo as a ChainInfo
+func (p *ChainTester) GetChainInfo() (*ChainInfo, error) {
+	value, err := p.GetInfo()
+	if err != nil {
+		return nil, err
+	}
+	return NewChainInfo(value)
+}
+
+func (p *ChainTester) setBlockTime(blockTime time.Time) error {
+	if p.InSession() {
+		return newErrorf("can not produce block while an undo session is open")
+	}
+
+	var _args interfaces.IPCChainTesterSetBlockTimeArgs
+	_args.ID = p.id
+	_args.BlockTime = blockTime.UnixNano() / int64(time.Microsecond)
+	var _result interfaces.IPCChainTesterSetBlockTimeResult
+	var _meta thrift.ResponseMeta
+
+	var _err error
+	_meta, _err = p.Call(defaultCtx, "set_block_time", &_args, &_result)
+	p.IPCChainTesterClient.SetLastResponseMeta_(_meta)
+	return _err
+}
+
+// SetBlockTime produces a block at blockTime, which must be later than the head block time.
+// Block timestamps are slots of BlockInterval, blockTime must be a multiple of BlockInterval.
+func (p *ChainTester) SetBlockTime(blockTime time.Time) error {
+	if blockTime.UnixNano()%int64(BlockInterval) != 0 {
+		return newErrorf("block time %v is not a multiple of %v", blockTime, BlockInterval)
+	}
+
+	info, err := p.GetChainInfo()
+	if err != nil {
+		return err
+	}
+
+	if !blockTime.After(info.HeadBlockTime) {
+		return newErrorf("block time %v is not later than head block time %v", blockTime, info.HeadBlockTime)
+	}
+	return p.setBlockTime(blockTime)
+}
+
+// AdvanceTime produces a block d after the head block time, d must be a positive multiple
+// of BlockInterval since block timestamps are slots of BlockInterval
+func (p *ChainTester) AdvanceTime(d time.Duration) error {
+	if d <= 0 || d%BlockInterval != 0 {
+		return newErrorf("invalid duration %v: must be a positive multiple of %v", d, BlockInterval)
+	}
+
+	info, err := p.GetChainInfo()
+	if err != nil {
+		return err
+	}
+	return p.setBlockTime(info.HeadBlockTime.Add(d))
+}
+
+// FreezeTime decouples block time from wall clock time. While time is frozen,
+// every produced block advances the head block time by exactly BlockInterval
+// plus the requested skip seconds.
+func (p *ChainTester) FreezeTime(freeze bool) {
+	p.timeFrozen = freeze
+}
+
+func (p *ChainTester) IsTimeFrozen() bool {
+	return p.timeFrozen
+}
+
+// ProduceBlocks produces n blocks
+func (p *ChainTester) ProduceBlocks(n int) error {
+	for i := 0; i < n; i++ {
+		if err := p.ProduceBlock(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
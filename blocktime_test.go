@@ -0,0 +1,56 @@
+package chaintester
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewChainInfo(t *testing.T) {
+	value := NewJsonValue([]byte(`{
+		"server_version": "b3a5b7c3",
+		"chain_id": "cf057bbfb72640471fd910bcb67639c22df9f92470936cddc1ade0e2f2e7dc4f",
+		"head_block_num": 12,
+		"last_irreversible_block_num": 11,
+		"last_irreversible_block_id": "0000000b",
+		"head_block_id": "0000000c",
+		"head_block_time": "2018-06-01T12:00:06.500",
+		"head_block_producer": "eosio",
+		"virtual_block_cpu_limit": 200000000,
+		"virtual_block_net_limit": 1048576000,
+		"block_cpu_limit": 199900,
+		"block_net_limit": 1048576
+	}`))
+
+	info, err := NewChainInfo(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.HeadBlockNum != 12 || info.LastIrreversibleBlockNum != 11 || info.HeadBlockProducer != "eosio" {
+		t.Fatalf("bad chain info: %+v", info)
+	}
+
+	expected := time.Date(2018, 6, 1, 12, 0, 6, 500*int(time.Millisecond), time.UTC)
+	if !info.HeadBlockTime.Equal(expected) {
+		t.Fatalf("expected head block time %v, got %v", expected, info.HeadBlockTime)
+	}
+
+	if _, err := NewChainInfo(NewJsonValue([]byte(`{"chain_id": "00"}`))); err == nil {
+		t.Fatal("incomplete chain info should fail")
+	}
+}
+
+func TestBlockTimeSlots(t *testing.T) {
+	tester := &ChainTester{}
+	for _, d := range []time.Duration{0, -BlockInterval, 1200 * time.Millisecond, time.Microsecond} {
+		if err := tester.AdvanceTime(d); err == nil || !strings.Contains(err.Error(), "multiple of") {
+			t.Errorf("duration %v: %v", d, err)
+		}
+	}
+
+	blockTime := time.Date(2022, 1, 1, 0, 0, 1, 200*int(time.Millisecond), time.UTC)
+	if err := tester.SetBlockTime(blockTime); err == nil || !strings.Contains(err.Error(), "multiple of") {
+		t.Errorf("block time %v: %v", blockTime, err)
+	}
+}
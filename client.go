@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"time"
 
 	"github.com/uuosio/chaintester/interfaces"
 
@@ -19,6 +20,12 @@ type ActionArguments interfaces.ActionArguments
 type IPCClient struct {
 	seqId        int32
 	iprot, oprot thrift.TProtocol
+	// name of the service called, used in recordings
+	service string
+	// called before a request is sent
+	beforeSend func(method string, args thrift.TStruct)
+	// serves calls from a recording instead of the server if not nil
+	replayer *Replayer
 }
 
 // IPCClient implements TClient, and uses the standard message format for Thrift.
@@ -86,19 +93,54 @@ func (p *IPCClient) Recv(ctx context.Context, iprot thrift.TProtocol, seqId int3
 }
 
 func (p *IPCClient) Call(ctx context.Context, method string, args, result thrift.TStruct) (thrift.ResponseMeta, error) {
+	return p.call(ctx, method, args, result, nil)
+}
+
+// call sends a request and receives its result, beforeRecv is called after the request is sent
+func (p *IPCClient) call(ctx context.Context, method string, args, result thrift.TStruct, beforeRecv func()) (_ thrift.ResponseMeta, err error) {
 	p.seqId++
 	seqId := p.seqId
 
+	if p.beforeSend != nil {
+		p.beforeSend(method, args)
+	}
+
+	start := time.Now()
+	GetLogger().Debug("ipc call", "service", p.service, "method", method, "seq", seqId)
+	span := p.startSpan(ctx, method, args)
+	defer func() {
+		span.end(err)
+		if err != nil {
+			GetLogger().Debug("ipc call failed", "service", p.service, "method", method, "seq", seqId, "duration", time.Since(start), "error", err)
+		} else {
+			GetLogger().Debug("ipc call returned", "service", p.service, "method", method, "seq", seqId, "duration", time.Since(start))
+		}
+	}()
+
+	record := GetRecorder().begin(p.service, method, args)
+	if p.replayer != nil {
+		err := p.replayer.replay(p.service, method, args, result, beforeRecv)
+		GetRecorder().end(record, result, err)
+		return thrift.ResponseMeta{}, err
+	}
+
 	if err := p.Send(ctx, p.oprot, seqId, method, args); err != nil {
+		GetRecorder().end(record, nil, err)
 		return thrift.ResponseMeta{}, err
 	}
 
 	// method is oneway
 	if result == nil {
+		GetRecorder().end(record, nil, nil)
 		return thrift.ResponseMeta{}, nil
 	}
 
-	err := p.Recv(ctx, p.iprot, seqId, method, result)
+	if beforeRecv != nil {
+		beforeRecv()
+	}
+
+	err = p.Recv(ctx, p.iprot, seqId, method, result)
+	GetRecorder().end(record, result, err)
 	var headers thrift.THeaderMap
 	if hp, ok := p.iprot.(*thrift.THeaderProtocol); ok {
 		transport := reflect.ValueOf(hp).Elem().FieldByName("transport")
@@ -116,8 +158,21 @@ type ChainTester struct {
 	interfaces.IPCChainTesterClient
 	client *IPCClient
 	id     int32
+
+	console       []ActionConsole
+	nativeConsole []ActionConsole
+	consoleLogger ConsoleLogger
+
+	timeFrozen bool
+	sessions   []*Session
+	keyStore   *KeyStore
+	resources  resourceUsage
+	simulation *Simulation
+	coreSymbol symbol
 }
 
+var g_ChainTesters = make(map[int32]*ChainTester)
+
 var g_ApplyRequestServer *ApplyRequestServer
 
 func GetApplyRequestServer() *ApplyRequestServer {
@@ -178,19 +233,27 @@ func SetVMAPIServerPort(port string) {
 
 func GetIPCClient() *IPCClient {
 	if g_IPCClient == nil {
-		addr := fmt.Sprintf("%s:%s", g_DebuggerConfig.DebuggerServerAddress, g_DebuggerConfig.DebuggerServerPort)
-		iprot, oprot, err := NewProtocol(addr)
-		if err != nil {
-			panic(err)
+		if g_Replayer != nil {
+			g_IPCClient = NewIPCClient(nil, nil)
+			g_IPCClient.replayer = g_Replayer
+		} else {
+			addr := fmt.Sprintf("%s:%s", g_DebuggerConfig.DebuggerServerAddress, g_DebuggerConfig.DebuggerServerPort)
+			iprot, oprot, err := NewProtocol(addr)
+			if err != nil {
+				panic(err)
+			}
+			g_IPCClient = NewIPCClient(iprot, oprot)
 		}
-		g_IPCClient = NewIPCClient(iprot, oprot)
+		g_IPCClient.service = "IPCChainTester"
 		tester := interfaces.NewIPCChainTesterClient(g_IPCClient)
 
 		tester.InitVMAPI(defaultCtx)
 		InitVMAPI() //init vm api client
 
 		tester.InitApplyRequest(defaultCtx)
-		GetApplyRequestServer() // init apply request server
+		if g_Replayer == nil {
+			GetApplyRequestServer() // init apply request server
+		}
 
 	}
 	return g_IPCClient
@@ -200,20 +263,74 @@ func GetIPCClient() *IPCClient {
 // 	func(ctx context.Context, method string, args github.com/apache/thrift/lib/go/thrift.TStruct, result github.com/apache/thrift/lib/go/thrift.TStruct) (chaintester.ResponseMeta, error), want
 // 	func(ctx context.Context, method string, args github.com/apache/thrift/lib/go/thrift.TStruct, result github.com/apache/thrift/lib/go/thrift.TStruct) (github.com/apache/thrift/lib/go/thrift.ResponseMeta, error))compilerInvalidIfaceAssign
 
-func NewChainTester() *ChainTester {
+// NewChainTester creates a chain with system contracts, or with options if they are given
+func NewChainTester(options ...ChainOptions) *ChainTester {
+	if len(options) > 1 {
+		panic("invalid arguments")
+	}
+
+	var opts ChainOptions
+	if len(options) == 1 {
+		opts = options[0]
+	}
+	tester, err := NewChainTesterWithOptions(opts)
+	if err != nil {
+		panic(err)
+	}
+	return tester
+}
+
+// NewChainTesterWithOptions creates a chain with options, the chain is freed if they can not be applied
+func NewChainTesterWithOptions(options ChainOptions) (*ChainTester, error) {
+	sym, err := options.validate()
+	if err != nil {
+		return nil, err
+	}
+
 	c := GetIPCClient()
 
 	tester := &ChainTester{
 		IPCChainTesterClient: *interfaces.NewIPCChainTesterClient(c),
 		client:               c,
+		coreSymbol:           sym,
 	}
 
-	var err error
-	tester.id, err = tester.NewChain_(defaultCtx, true)
+	initialize := options.Contracts == SystemContracts && !options.deployContracts()
+	tester.id, err = tester.NewChain_(defaultCtx, initialize)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return tester
+	g_ChainTesters[tester.id] = tester
+
+	if err := options.bootstrap(tester); err != nil {
+		tester.FreeChain()
+		return nil, newErrorf("bootstrap chain: %v", err)
+	}
+	return tester, nil
+}
+
+// AttachChainTester returns a ChainTester of the chain id on the server, which was created
+// by another process and has not been freed. It allows tools to work on the same chain
+// across processes. The core symbol is read from eosio.system, it is 4,EOS without it.
+func AttachChainTester(id int32) (*ChainTester, error) {
+	c := GetIPCClient()
+
+	tester := &ChainTester{
+		IPCChainTesterClient: *interfaces.NewIPCChainTesterClient(c),
+		client:               c,
+		id:                   id,
+	}
+	if _, err := tester.GetInfo(); err != nil {
+		return nil, newErrorf("chain %d not found: %v", id, err)
+	}
+	tester.coreSymbol = tester.readCoreSymbol()
+	g_ChainTesters[id] = tester
+	return tester, nil
+}
+
+// ID returns the id of the chain on the server
+func (p *ChainTester) ID() int32 {
+	return p.id
 }
 
 func (p *ChainTester) SetNativeApply(contract string, apply func(uint64, uint64, uint64)) {
@@ -233,36 +350,54 @@ func (p *ChainTester) SetNativeApply(contract string, apply func(uint64, uint64,
 	}
 }
 
-func (p *ChainTester) Call(ctx context.Context, method string, args, result thrift.TStruct) (thrift.ResponseMeta, error) {
-	p.client.seqId++
-	seqId := p.client.seqId
-
-	if err := p.client.Send(ctx, p.client.oprot, seqId, method, args); err != nil {
-		return thrift.ResponseMeta{}, err
-	}
+// pushPrivileged pushes action to eosio, which is applied by call in a temporary native apply
+// of eosio, so call can use the intrinsics of the VM API which require a privileged account
+func (p *ChainTester) pushPrivileged(action string, call func() error) (*JsonValue, error) {
+	prevApply := g_ChainTesterApplyMap[p.id]["eosio"]
+	defer p.SetNativeApply("eosio", prevApply)
 
-	// method is oneway
-	if result == nil {
-		return thrift.ResponseMeta{}, nil
-	}
+	p.SetNativeApply("eosio", func(receiver uint64, firstReceiver uint64, act uint64) {
+		if act != S2N(action) {
+			panic(fmt.Errorf("unexpected action %s while pushing %s", N2S(act), action))
+		}
+		if err := call(); err != nil {
+			panic(err)
+		}
+	})
+	return p.PushAction("eosio", action, []byte{}, `{"eosio": "active"}`)
+}
 
-	//runApplyRequestServer
+// methods which may execute contracts, native apply requests are served while they are called
+var applyRequestMethods = map[string]bool{
+	"push_action":             true,
+	"push_actions":            true,
+	"push_transaction":        true,
+	"execute_deferred":        true,
+	"push_signed_transaction": true,
+	"produce_block":           true,
+	"set_block_time":          true,
+}
 
-	//start apply request server
-	if "push_action" == method || "push_actions" == method || "produce_block" == method {
-		GetApplyRequestServer().Serve()
+func (p *ChainTester) Call(ctx context.Context, method string, args, result thrift.TStruct) (thrift.ResponseMeta, error) {
+	if p.simulation != nil && simulatedMethods[method] {
+		return p.simulation.call(ctx, p, method, args, result)
 	}
+	return p.call(ctx, method, args, result)
+}
 
-	err := p.client.Recv(ctx, p.client.iprot, seqId, method, result)
-	var headers thrift.THeaderMap
-	if hp, ok := p.client.iprot.(*thrift.THeaderProtocol); ok {
-		transport := reflect.ValueOf(hp).Elem().FieldByName("transport")
-		readHeaders := reflect.ValueOf(transport).Elem().FieldByName("readHeaders")
-		headers = readHeaders.Interface().(thrift.THeaderMap)
+func (p *ChainTester) call(ctx context.Context, method string, args, result thrift.TStruct) (thrift.ResponseMeta, error) {
+	if !applyRequestMethods[method] {
+		return p.client.Call(ctx, method, args, result)
 	}
-	return thrift.ResponseMeta{
-		Headers: headers,
-	}, err
+
+	//start apply request server
+	return p.client.call(ctx, method, args, result, func() {
+		if p.client.replayer != nil {
+			p.client.replayer.serveApplyRequests()
+		} else {
+			GetApplyRequestServer().Serve()
+		}
+	})
 }
 
 func (p *ChainTester) pushAction(account string, action string, arguments *interfaces.ActionArguments, permissions string) (*JsonValue, error) {
@@ -280,19 +415,25 @@ func (p *ChainTester) pushAction(account string, action string, arguments *inter
 		panic(_err)
 	}
 	p.IPCChainTesterClient.SetLastResponseMeta_(_meta21)
-	ret := _result22.GetSuccess()
+	return p.parseTrace(_result22.GetSuccess())
+}
 
+// parseTrace parses the trace of a transaction and collects its console output and resource usage,
+// a TransactionError is returned if the transaction failed
+func (p *ChainTester) parseTrace(ret []byte) (*JsonValue, error) {
 	value := &JsonValue{}
-	// fmt.Printf("++++++push_action return: %v", string(ret))
 	err := json.Unmarshal(ret, value)
 	if err != nil {
 		return nil, err
 	}
 
+	p.collectConsole(ret)
 	_, err = value.Get("except")
 	if err == nil {
-		return nil, NewTransactionError(ret)
+		return nil, &TransactionError{Err: ret, console: p.console}
 	} else {
+		p.resources.collect(ret)
+		value.console = p.console
 		return value, nil
 	}
 }
@@ -324,26 +465,13 @@ func (p *ChainTester) PushActions(actions []*interfaces.Action) (*JsonValue, err
 	if _err != nil {
 		return nil, _err
 	}
-	ret := _result31.GetSuccess()
-	value := &JsonValue{}
-	// fmt.Printf("++++++push_action return: %v", string(ret))
-	err := json.Unmarshal(ret, value)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = value.Get("except")
-	if err == nil {
-		return nil, NewTransactionError(ret)
-	} else {
-		return value, nil
-	}
+	return p.parseTrace(_result31.GetSuccess())
 }
 
-func (p *ChainTester) DeployContract(account string, wasmFile string, abiFile string) (err error) {
+func (p *ChainTester) deployContractActions(account string, wasmFile string, abiFile string) ([]*interfaces.Action, error) {
 	wasm, err := os.ReadFile(wasmFile)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	hexWasm := make([]byte, len(wasm)*2)
@@ -380,9 +508,12 @@ func (p *ChainTester) DeployContract(account string, wasmFile string, abiFile st
 	if abiFile != "" {
 		abi, err := os.ReadFile(abiFile)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		rawAbi, err := p.PackAbi(string(abi))
+		if err != nil {
+			return nil, err
 		}
-		rawAbi, _ := p.PackAbi(string(abi))
 		hexRawAbi := make([]byte, len(rawAbi)*2)
 		hex.Encode(hexRawAbi, rawAbi)
 		jsonArgs := fmt.Sprintf(
@@ -406,6 +537,15 @@ func (p *ChainTester) DeployContract(account string, wasmFile string, abiFile st
 		actions = append(actions, setAbiAction)
 	}
 
+	return actions, nil
+}
+
+func (p *ChainTester) DeployContract(account string, wasmFile string, abiFile string) (err error) {
+	actions, err := p.deployContractActions(account, wasmFile, abiFile)
+	if err != nil {
+		return err
+	}
+
 	_, err = p.PushActions(actions)
 	if err != nil {
 		return err
@@ -414,6 +554,14 @@ func (p *ChainTester) DeployContract(account string, wasmFile string, abiFile st
 }
 
 func (p *ChainTester) produceBlock(next_block_skip_seconds int64) error {
+	if p.InSession() {
+		return newErrorf("can not produce block while an undo session is open")
+	}
+
+	if p.timeFrozen {
+		return p.AdvanceTime(BlockInterval + time.Duration(next_block_skip_seconds)*time.Second)
+	}
+
 	var _args41 interfaces.IPCChainTesterProduceBlockArgs
 	_args41.ID = p.id
 	_args41.NextBlockSkipSeconds = next_block_skip_seconds
@@ -472,6 +620,44 @@ func (p *ChainTester) CreateKey(keyType ...string) (*JsonValue, error) {
 	return value, nil
 }
 
+// ImportKey imports a key pair into the wallet of the chain, transactions are signed
+// with the imported keys which are required by their authorizations
+func (p *ChainTester) ImportKey(pubKey string, privKey string) error {
+	ok, err := p.IPCChainTesterClient.ImportKey(defaultCtx, p.id, pubKey, privKey)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return newErrorf("import key %s failed", pubKey)
+	}
+	return nil
+}
+
+// GetRequiredKeys returns the subset of availableKeys required to sign transaction,
+// which is a transaction in JSON format with packed action data
+func (p *ChainTester) GetRequiredKeys(transaction string, availableKeys []string) ([]string, error) {
+	ret, err := p.IPCChainTesterClient.GetRequiredKeys(defaultCtx, p.id, transaction, availableKeys)
+	if err != nil {
+		return nil, err
+	}
+	return parseRequiredKeys(ret)
+}
+
+func parseRequiredKeys(ret string) ([]string, error) {
+	var keys []string
+	if err := json.Unmarshal([]byte(ret), &keys); err == nil {
+		return keys, nil
+	}
+
+	var result struct {
+		RequiredKeys []string `json:"required_keys"`
+	}
+	if err := json.Unmarshal([]byte(ret), &result); err != nil || result.RequiredKeys == nil {
+		return nil, newErrorf("%v", ret)
+	}
+	return result.RequiredKeys, nil
+}
+
 func (p *ChainTester) GetAccount(account string) (*JsonValue, error) {
 	ret, err := p.IPCChainTesterClient.GetAccount(defaultCtx, p.id, account)
 	value := &JsonValue{}
@@ -544,7 +730,7 @@ func (p *ChainTester) GetTableRowsEx(_json bool, code string, scope string, tabl
 
 func (tester *ChainTester) GetBalance(account string, extras ...string) uint64 {
 	tokenAccount := "eosio.token"
-	symbol := "EOS"
+	symbol := tester.coreSymbol.name
 
 	if len(extras) == 1 {
 		tokenAccount = extras[0]
@@ -580,6 +766,7 @@ func (p *ChainTester) PackAbi(abi string) ([]byte, error) {
 
 func (p *ChainTester) FreeChain() (int32, error) {
 	delete(g_ChainTesterApplyMap, p.id)
+	delete(g_ChainTesters, p.id)
 	return p.IPCChainTesterClient.FreeChain(defaultCtx, p.id)
 }
 
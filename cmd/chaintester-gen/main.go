@@ -0,0 +1,59 @@
+// Command chaintester-gen generates a typed Go client of a contract from its ABI file.
+//
+//	chaintester-gen -abi hello.abi -package hello -o hello/client.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/uuosio/chaintester/abigen"
+)
+
+func packageName(abiFile string) string {
+	name := strings.TrimSuffix(filepath.Base(abiFile), filepath.Ext(abiFile))
+	return strings.ToLower(abigen.GoName(name))
+}
+
+func main() {
+	abiFile := flag.String("abi", "", "path of the contract ABI file")
+	pkg := flag.String("package", "", "package name of the generated code, derived from the ABI file name by default")
+	output := flag.String("o", "", "output file, the generated code is written to stdout by default")
+	flag.Parse()
+
+	if *abiFile == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *pkg == "" {
+		*pkg = packageName(*abiFile)
+	}
+
+	if err := run(*abiFile, *pkg, *output); err != nil {
+		fmt.Fprintln(os.Stderr, "chaintester-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(abiFile, pkg, output string) error {
+	data, err := os.ReadFile(abiFile)
+	if err != nil {
+		return err
+	}
+	abi, err := abigen.ParseABI(data)
+	if err != nil {
+		return err
+	}
+	src, err := abigen.Generate(abi, pkg)
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(output, src, 0644)
+}
@@ -0,0 +1,399 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/uuosio/chaintester"
+)
+
+// argKind is the kind of an argument, used to complete it in the REPL
+type argKind int
+
+const (
+	anyArg argKind = iota
+	accountArg
+	contractArg
+	actionArg
+	tableArg
+	fileArg
+)
+
+// invocation is a parsed command
+type invocation struct {
+	// run runs the command, informational messages are written to log
+	run func(tester *chaintester.ChainTester, log io.Writer) (*chaintester.JsonValue, error)
+	// code returns Go statements which do the same as the command did, err is the error returned
+	// by run. It is nil for commands which do not change the chain.
+	code func(err error) string
+
+	// an account created by the command
+	account string
+	// a contract deployed by the command and its ABI file
+	contract string
+	abiFile  string
+}
+
+type command struct {
+	usage       string
+	description string
+	minArgs     int
+	maxArgs     int
+	// kinds of positional arguments and of flag values
+	args     []argKind
+	flagArgs map[string]argKind
+	// setup defines the flags of the command and returns a function which
+	// creates the invocation of the parsed positional arguments
+	setup func(flags *flag.FlagSet) func(args []string) (*invocation, error)
+}
+
+var commands = map[string]*command{
+	"info":           infoCommand,
+	"create-key":     createKeyCommand,
+	"create-account": createAccountCommand,
+	"deploy":         deployCommand,
+	"push":           pushCommand,
+	"table":          tableCommand,
+	"produce-block":  produceBlockCommand,
+}
+
+func newFlagSet(name string, cmd *command, output io.Writer) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(output)
+	flags.Usage = func() {
+		fmt.Fprintf(output, "usage: %s %s\n\n%s\n", name, cmd.usage, cmd.description)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// parseCommand parses the arguments of the command name, flag.ErrHelp is returned if help is requested
+func parseCommand(name string, args []string, output io.Writer) (*invocation, error) {
+	cmd, ok := commands[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown command %q", name)
+	}
+
+	flags := newFlagSet(name, cmd, output)
+	build := cmd.setup(flags)
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() < cmd.minArgs || flags.NArg() > cmd.maxArgs {
+		flags.Usage()
+		return nil, fmt.Errorf("%s: wrong number of arguments", name)
+	}
+	return build(flags.Args())
+}
+
+// parseAuth parses authorizations like "alice@active,bob", the permission defaults to active
+func parseAuth(auth string) ([]chaintester.PermissionLevel, error) {
+	var levels []chaintester.PermissionLevel
+	for _, s := range strings.Split(auth, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		level := chaintester.PermissionLevel{Actor: s, Permission: "active"}
+		if i := strings.IndexByte(s, '@'); i >= 0 {
+			level.Actor, level.Permission = s[:i], s[i+1:]
+		}
+		if level.Actor == "" || level.Permission == "" {
+			return nil, fmt.Errorf("invalid authorization %q", s)
+		}
+		levels = append(levels, level)
+	}
+	return levels, nil
+}
+
+// checkStatement returns a statement which fails the test if the call returns an error
+func checkStatement(call string) string {
+	return fmt.Sprintf("if err := %s; err != nil {\n\tt.Fatal(err)\n}\n", call)
+}
+
+var infoCommand = &command{
+	usage:       "",
+	description: "Print the chain info.",
+	setup: func(flags *flag.FlagSet) func(args []string) (*invocation, error) {
+		return func(args []string) (*invocation, error) {
+			return &invocation{
+				run: func(tester *chaintester.ChainTester, log io.Writer) (*chaintester.JsonValue, error) {
+					return tester.GetInfo()
+				},
+			}, nil
+		}
+	},
+}
+
+var createKeyCommand = &command{
+	usage:       "[-type K1|R1]",
+	description: "Create a key pair.",
+	setup: func(flags *flag.FlagSet) func(args []string) (*invocation, error) {
+		keyType := flags.String("type", "K1", "key type, K1 or R1")
+		return func(args []string) (*invocation, error) {
+			return &invocation{
+				run: func(tester *chaintester.ChainTester, log io.Writer) (*chaintester.JsonValue, error) {
+					return tester.CreateKey(*keyType)
+				},
+			}, nil
+		}
+	},
+}
+
+var createAccountCommand = &command{
+	usage:       "[flags] <name>",
+	description: "Create an account, a key pair is created and imported if -key is not specified.",
+	minArgs:     1,
+	maxArgs:     1,
+	flagArgs:    map[string]argKind{"creator": accountArg},
+	setup: func(flags *flag.FlagSet) func(args []string) (*invocation, error) {
+		creator := flags.String("creator", "eosio", "creator of the account")
+		ownerKey := flags.String("key", "", "public key of the owner permission")
+		activeKey := flags.String("active-key", "", "public key of the active permission, defaults to -key")
+		privateKey := flags.String("private-key", "", "private key to import for signing")
+		ram := flags.Int64("ram", 10*1024*1024, "RAM bytes")
+		net := flags.Int64("net", 0, "staked NET")
+		cpu := flags.Int64("cpu", 0, "staked CPU")
+
+		return func(args []string) (*invocation, error) {
+			name := args[0]
+			return &invocation{
+				account: name,
+				run: func(tester *chaintester.ChainTester, log io.Writer) (*chaintester.JsonValue, error) {
+					if *ownerKey == "" {
+						key, err := tester.CreateKey()
+						if err != nil {
+							return nil, err
+						}
+						if *ownerKey, err = key.GetString("public"); err != nil {
+							return nil, err
+						}
+						if *privateKey, err = key.GetString("private"); err != nil {
+							return nil, err
+						}
+						fmt.Fprintf(log, "key: %s %s\n", *ownerKey, *privateKey)
+					}
+					if *activeKey == "" {
+						*activeKey = *ownerKey
+					}
+					if *privateKey != "" {
+						if err := tester.ImportKey(*ownerKey, *privateKey); err != nil {
+							return nil, err
+						}
+					}
+
+					if _, err := tester.CreateAccount(*creator, name, *ownerKey, *activeKey, *ram, *net, *cpu); err != nil {
+						return nil, err
+					}
+					return tester.GetAccount(name)
+				},
+				code: func(err error) string {
+					if err != nil {
+						return ""
+					}
+					code := ""
+					if *privateKey != "" {
+						code += checkStatement(fmt.Sprintf("tester.ImportKey(%q, %q)", *ownerKey, *privateKey))
+					}
+					call := fmt.Sprintf("tester.CreateAccount(%q, %q, %q, %q, %d, %d, %d)", *creator, name, *ownerKey, *activeKey, *ram, *net, *cpu)
+					return code + fmt.Sprintf("if _, err := %s; err != nil {\n\tt.Fatal(err)\n}\n", call)
+				},
+			}, nil
+		}
+	},
+}
+
+var deployCommand = &command{
+	usage:       "[-abi file] <account> <wasm>",
+	description: "Deploy a contract, the ABI file next to the wasm file is deployed by default.",
+	minArgs:     2,
+	maxArgs:     2,
+	args:        []argKind{accountArg, fileArg},
+	flagArgs:    map[string]argKind{"abi": fileArg},
+	setup: func(flags *flag.FlagSet) func(args []string) (*invocation, error) {
+		abi := flags.String("abi", "", "ABI file")
+
+		return func(args []string) (*invocation, error) {
+			account, wasm := args[0], args[1]
+			if *abi == "" {
+				defaultABI := strings.TrimSuffix(wasm, ".wasm") + ".abi"
+				if _, err := os.Stat(defaultABI); err == nil {
+					*abi = defaultABI
+				}
+			}
+
+			return &invocation{
+				contract: account,
+				abiFile:  *abi,
+				run: func(tester *chaintester.ChainTester, log io.Writer) (*chaintester.JsonValue, error) {
+					if err := tester.DeployContract(account, wasm, *abi); err != nil {
+						return nil, err
+					}
+					return tester.GetAccount(account)
+				},
+				code: func(err error) string {
+					if err != nil {
+						return ""
+					}
+					return checkStatement(fmt.Sprintf("tester.DeployContract(%q, %q, %q)", account, wasm, *abi))
+				},
+			}, nil
+		}
+	},
+}
+
+var pushCommand = &command{
+	usage:       "[flags] <account> <action> [json arguments]",
+	description: "Push an action and print its transaction trace.",
+	minArgs:     2,
+	maxArgs:     3,
+	args:        []argKind{contractArg, actionArg},
+	flagArgs:    map[string]argKind{"auth": accountArg},
+	setup: func(flags *flag.FlagSet) func(args []string) (*invocation, error) {
+		auth := flags.String("auth", "", "comma separated authorizations like alice@active, defaults to <account>@active")
+		raw := flags.Bool("raw", false, "arguments are hex encoded packed action data")
+
+		return func(args []string) (*invocation, error) {
+			account, action := args[0], args[1]
+			arguments := "{}"
+			if len(args) == 3 {
+				arguments = args[2]
+			}
+
+			levels, err := parseAuth(*auth)
+			if err != nil {
+				return nil, err
+			}
+			if len(levels) == 0 {
+				levels = []chaintester.PermissionLevel{{Actor: account, Permission: "active"}}
+			}
+			var data []byte
+			if *raw {
+				if data, err = hex.DecodeString(arguments); err != nil {
+					return nil, fmt.Errorf("invalid hex arguments: %v", err)
+				}
+			}
+
+			return &invocation{
+				run: func(tester *chaintester.ChainTester, log io.Writer) (*chaintester.JsonValue, error) {
+					sender := chaintester.NewActionSender(tester)
+					var err error
+					if *raw {
+						err = sender.AddActionWithAuthEx(account, action, data, levels...)
+					} else {
+						err = sender.AddActionWithAuth(account, action, arguments, levels...)
+					}
+					if err != nil {
+						return nil, err
+					}
+
+					trace, err := sender.Send()
+					for _, console := range tester.Console() {
+						if console.Console != "" {
+							fmt.Fprintf(log, "[%s->%s::%s] %s\n", console.Receiver, console.Account, console.Action, strings.TrimSuffix(console.Console, "\n"))
+						}
+					}
+					return trace, err
+				},
+				code: func(err error) string {
+					var trxErr *chaintester.TransactionError
+					if err != nil && !errors.As(err, &trxErr) {
+						return ""
+					}
+
+					auth := make([]string, 0, len(levels))
+					for _, level := range levels {
+						auth = append(auth, fmt.Sprintf("chaintester.PermissionLevel{Actor: %q, Permission: %q}", level.Actor, level.Permission))
+					}
+					add := fmt.Sprintf("sender.AddActionWithAuth(%q, %q, %q, %s)", account, action, arguments, strings.Join(auth, ", "))
+					if *raw {
+						add = fmt.Sprintf("sender.AddActionWithAuthEx(%q, %q, %#v, %s)", account, action, data, strings.Join(auth, ", "))
+					}
+
+					code := "sender := chaintester.NewActionSender(tester)\n"
+					if trxErr != nil {
+						code += fmt.Sprintf("sender.ExpectFailure(%q)\n", trxErr.AssertMessage())
+					}
+					code += checkStatement(add)
+					code += "if _, err := sender.Send(); err != nil {\n\tt.Fatal(err)\n}\n"
+					return "{\n" + code + "}\n"
+				},
+			}, nil
+		}
+	},
+}
+
+var tableCommand = &command{
+	usage:       "[flags] <code> <table>",
+	description: "Print rows of a table.",
+	minArgs:     2,
+	maxArgs:     2,
+	args:        []argKind{contractArg, tableArg},
+	flagArgs:    map[string]argKind{"scope": accountArg},
+	setup: func(flags *flag.FlagSet) func(args []string) (*invocation, error) {
+		scope := flags.String("scope", "", "scope of the table, defaults to the code")
+		lower := flags.String("lower", "", "lower bound")
+		upper := flags.String("upper", "", "upper bound")
+		limit := flags.Int64("limit", 10, "maximum number of rows")
+		index := flags.String("index", "", "index position, 1 for the primary index, 2 for the first secondary index")
+		keyType := flags.String("key-type", "", "key type of the index, i64, i128, i256, float64, float128, sha256, ripemd160 or name")
+		reverse := flags.Bool("reverse", false, "return rows in reverse order")
+		payer := flags.Bool("payer", false, "show RAM payers of rows")
+		binary := flags.Bool("binary", false, "return hex encoded packed rows instead of decoding them with the ABI")
+
+		return func(args []string) (*invocation, error) {
+			code, table := args[0], args[1]
+			if *scope == "" {
+				*scope = code
+			}
+			return &invocation{
+				run: func(tester *chaintester.ChainTester, log io.Writer) (*chaintester.JsonValue, error) {
+					return tester.GetTableRowsEx(!*binary, code, *scope, table, *lower, *upper, *limit, *keyType, *index, "", *reverse, *payer)
+				},
+			}, nil
+		}
+	},
+}
+
+var produceBlockCommand = &command{
+	usage:       "[-n count] [-skip seconds]",
+	description: "Produce blocks.",
+	setup: func(flags *flag.FlagSet) func(args []string) (*invocation, error) {
+		n := flags.Int("n", 1, "number of blocks")
+		skip := flags.Int64("skip", 0, "seconds to skip before the first block")
+
+		return func(args []string) (*invocation, error) {
+			if *n < 1 {
+				return nil, fmt.Errorf("invalid number of blocks: %d", *n)
+			}
+			return &invocation{
+				run: func(tester *chaintester.ChainTester, log io.Writer) (*chaintester.JsonValue, error) {
+					if err := tester.ProduceBlock(*skip); err != nil {
+						return nil, err
+					}
+					if *n > 1 {
+						if err := tester.ProduceBlocks(*n - 1); err != nil {
+							return nil, err
+						}
+					}
+					return tester.GetInfo()
+				},
+				code: func(err error) string {
+					if err != nil {
+						return ""
+					}
+					code := checkStatement(fmt.Sprintf("tester.ProduceBlock(%d)", *skip))
+					if *n > 1 {
+						code += checkStatement(fmt.Sprintf("tester.ProduceBlocks(%d)", *n-1))
+					}
+					return code
+				},
+			}, nil
+		}
+	},
+}
@@ -0,0 +1,191 @@
+// Command chaintester runs chain tester requests from the command line.
+//
+//	chaintester [global flags] <command> [flags] [arguments]
+//
+// A new chain is created for every run and freed when the command finishes. Use -keep to
+// keep it and -chain to run later commands on it, or -fixture to set it up from a fixture file.
+// The repl command opens an interactive session on the chain.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+
+	"github.com/uuosio/chaintester"
+)
+
+type options struct {
+	server       string
+	vmAPI        string
+	applyRequest string
+	chain        int
+	keep         bool
+	fixture      string
+	compact      bool
+}
+
+func usage(globals *flag.FlagSet) {
+	out := globals.Output()
+	fmt.Fprintf(out, "usage: chaintester [global flags] <command> [flags] [arguments]\n\ncommands:\n")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(out, "  %-16s %s\n", name, commands[name].description)
+	}
+	fmt.Fprintf(out, "  %-16s %s\n", "repl", replDescription)
+	fmt.Fprintf(out, "\nglobal flags:\n")
+	globals.PrintDefaults()
+	fmt.Fprintf(out, "\nrun 'chaintester <command> -h' for the flags of a command\n")
+}
+
+func main() {
+	config := chaintester.GetDebuggerConfig()
+	opts := &options{}
+
+	globals := flag.NewFlagSet("chaintester", flag.ExitOnError)
+	globals.StringVar(&opts.server, "server", net.JoinHostPort(config.DebuggerServerAddress, config.DebuggerServerPort), "address of the chain tester server")
+	globals.StringVar(&opts.vmAPI, "vm-api", net.JoinHostPort(config.VMAPIServerAddress, config.VMAPIServerPort), "address of the VM API server")
+	globals.StringVar(&opts.applyRequest, "apply-request", net.JoinHostPort(config.ApplyRequestServerAddress, config.ApplyRequestServerPort), "address the apply request server listens on")
+	globals.IntVar(&opts.chain, "chain", 0, "id of a kept chain to run the command on, a new chain is created by default")
+	globals.BoolVar(&opts.keep, "keep", false, "keep the chain after the command finishes and print its id to stderr")
+	globals.StringVar(&opts.fixture, "fixture", "", "fixture file to load before the command")
+	globals.BoolVar(&opts.compact, "compact", false, "print compact JSON instead of indented JSON")
+	globals.Usage = func() { usage(globals) }
+	globals.Parse(os.Args[1:])
+
+	if globals.NArg() == 0 {
+		globals.Usage()
+		os.Exit(2)
+	}
+	name, args := globals.Arg(0), globals.Args()[1:]
+
+	var err error
+	if name == "repl" {
+		err = runREPL(opts, args)
+	} else {
+		var inv *invocation
+		inv, err = parseCommand(name, args, os.Stderr)
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(0)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "chaintester:", err)
+			if _, ok := commands[name]; !ok {
+				globals.Usage()
+			}
+			os.Exit(2)
+		}
+		err = run(opts, inv)
+	}
+
+	if err != nil {
+		reportError(opts, os.Stdout, os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// reportError prints the trace of a failed transaction to out and the error to log
+func reportError(opts *options, out io.Writer, log io.Writer, err error) {
+	var trxErr *chaintester.TransactionError
+	if errors.As(err, &trxErr) {
+		if value := trxErr.Json(); value != nil {
+			printValue(opts, out, value)
+		}
+		fmt.Fprintln(log, "chaintester:", trxErr.AssertMessage())
+		return
+	}
+	fmt.Fprintln(log, "chaintester:", err)
+}
+
+func setAddress(address string, setAddr func(string), setPort func(string)) error {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	setAddr(host)
+	setPort(port)
+	return nil
+}
+
+// connect connects to the server and returns the chain to run commands on, the chain
+// is freed by calling free
+func connect(opts *options) (tester *chaintester.ChainTester, free func(), err error) {
+	if err := setAddress(opts.server, chaintester.SetDebuggerServerAddress, chaintester.SetDebuggerServerPort); err != nil {
+		return nil, nil, err
+	}
+	if err := setAddress(opts.vmAPI, chaintester.SetVMAPIServerAddress, chaintester.SetVMAPIServerPort); err != nil {
+		return nil, nil, err
+	}
+	if err := setAddress(opts.applyRequest, chaintester.SetApplyRequestServerAddress, chaintester.SetApplyRequestServerPort); err != nil {
+		return nil, nil, err
+	}
+
+	// the client panics if the server is not running
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("connect to %s: %v", opts.server, r)
+		}
+	}()
+
+	free = func() {}
+	if opts.chain != 0 {
+		tester, err = chaintester.AttachChainTester(int32(opts.chain))
+		return tester, free, err
+	}
+
+	tester = chaintester.NewChainTester()
+	if opts.keep {
+		fmt.Fprintf(os.Stderr, "chain: %d\n", tester.ID())
+	} else {
+		free = func() { tester.FreeChain() }
+	}
+	return tester, free, nil
+}
+
+func run(opts *options, inv *invocation) error {
+	tester, free, err := connect(opts)
+	if err != nil {
+		return err
+	}
+	defer free()
+
+	if opts.fixture != "" {
+		if _, err := chaintester.LoadFixture(tester, opts.fixture); err != nil {
+			return err
+		}
+	}
+	return execute(opts, tester, inv, os.Stdout, os.Stderr)
+}
+
+// execute runs a command, prints its result to out and its messages to log
+func execute(opts *options, tester *chaintester.ChainTester, inv *invocation, out io.Writer, log io.Writer) error {
+	value, err := inv.run(tester, log)
+	if err != nil {
+		return err
+	}
+	if value != nil {
+		printValue(opts, out, value)
+	}
+	return nil
+}
+
+func printValue(opts *options, out io.Writer, value *chaintester.JsonValue) {
+	if opts.compact {
+		data, err := value.MarshalJSON()
+		if err != nil {
+			fmt.Fprintln(out, value.ToString())
+			return
+		}
+		fmt.Fprintln(out, string(data))
+		return
+	}
+	fmt.Fprintln(out, value.String())
+}
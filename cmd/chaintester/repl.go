@@ -0,0 +1,633 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/uuosio/chaintester"
+	"github.com/uuosio/chaintester/abigen"
+	"golang.org/x/term"
+)
+
+const replDescription = "Open an interactive session on the chain."
+
+const replHelp = `commands:
+  info, create-key, create-account, deploy, push, table, produce-block
+                     run a chaintester command, '<command> -h' prints its flags
+  history            print the commands of the session
+  export [-package name] <file>
+                     write the commands which changed the chain as a Go test
+  help               print this help
+  exit, quit         end the session
+
+Arguments are split like in a shell, quote JSON arguments: push hello inc '{"name": "go"}'
+Press tab to complete commands, accounts, contracts, actions and tables.
+`
+
+var replCommands = []string{"exit", "export", "help", "history", "quit"}
+
+type session struct {
+	opts   *options
+	tester *chaintester.ChainTester
+	out    io.Writer
+	log    io.Writer
+
+	// accounts which can be completed
+	accounts map[string]bool
+	// ABIs of deployed contracts, nil if the ABI is unknown
+	contracts map[string]*abigen.ABI
+
+	history []string
+	// Go statements of the commands which changed the chain
+	statements []string
+	fixture    string
+}
+
+func newSession(opts *options, tester *chaintester.ChainTester, out io.Writer, log io.Writer) *session {
+	return &session{
+		opts:      opts,
+		tester:    tester,
+		out:       out,
+		log:       log,
+		accounts:  map[string]bool{"eosio": true},
+		contracts: map[string]*abigen.ABI{},
+	}
+}
+
+// splitArgs splits a line into arguments like a shell does, arguments are quoted
+// with single or double quotes, and backslashes escape characters outside single quotes
+func splitArgs(line string) ([]string, error) {
+	var args []string
+	var arg strings.Builder
+	inArg := false
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+			} else {
+				arg.WriteByte(c)
+			}
+		case c == '\\':
+			if i+1 >= len(line) {
+				return nil, errors.New("trailing backslash")
+			}
+			i++
+			arg.WriteByte(line[i])
+			inArg = true
+		case quote == '"':
+			if c == '"' {
+				quote = 0
+			} else {
+				arg.WriteByte(c)
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			inArg = true
+		case c == ' ' || c == '\t':
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteByte(c)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c", quote)
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
+
+func (s *session) addAccount(account string) {
+	s.accounts[account] = true
+}
+
+func (s *session) addContract(account string, abiFile string) {
+	s.addAccount(account)
+	s.contracts[account] = nil
+	if abiFile == "" {
+		return
+	}
+	data, err := os.ReadFile(abiFile)
+	if err != nil {
+		return
+	}
+	if abi, err := abigen.ParseABI(data); err == nil {
+		s.contracts[account] = abi
+	}
+}
+
+func (s *session) loadFixture(path string) error {
+	fixture, err := chaintester.LoadFixture(s.tester, path)
+	if err != nil {
+		return err
+	}
+	s.fixture = path
+
+	for _, account := range fixture.Accounts {
+		s.addAccount(account.Name)
+	}
+	for _, contract := range fixture.Contracts {
+		abiFile := contract.Abi
+		if abiFile != "" && !filepath.IsAbs(abiFile) {
+			abiFile = filepath.Join(filepath.Dir(path), abiFile)
+		}
+		s.addContract(contract.Account, abiFile)
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isBoolFlag(flags *flag.FlagSet, name string) bool {
+	f := flags.Lookup(name)
+	if f == nil {
+		return false
+	}
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
+// candidates returns the completions of the last argument of a line
+func (s *session) candidates(args []string, current string) []string {
+	if len(args) == 0 {
+		names := append([]string{}, replCommands...)
+		for name := range commands {
+			names = append(names, name)
+		}
+		return names
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		return nil
+	}
+	flags := newFlagSet(args[0], cmd, io.Discard)
+	cmd.setup(flags)
+
+	if strings.HasPrefix(current, "-") {
+		var names []string
+		flags.VisitAll(func(f *flag.Flag) {
+			names = append(names, "-"+f.Name)
+		})
+		return names
+	}
+
+	var positional []string
+	kind := anyArg
+	valueOf := ""
+	for _, arg := range args[1:] {
+		if valueOf != "" {
+			valueOf = ""
+			continue
+		}
+		if strings.HasPrefix(arg, "-") {
+			name := strings.TrimLeft(arg, "-")
+			if !strings.Contains(name, "=") && !isBoolFlag(flags, name) {
+				valueOf = name
+			}
+			continue
+		}
+		positional = append(positional, arg)
+	}
+	if valueOf != "" {
+		kind = cmd.flagArgs[valueOf]
+	} else if len(positional) < len(cmd.args) {
+		kind = cmd.args[len(positional)]
+	}
+
+	switch kind {
+	case accountArg:
+		return sortedKeys(s.accounts)
+	case contractArg:
+		var names []string
+		for name := range s.contracts {
+			names = append(names, name)
+		}
+		return names
+	case actionArg, tableArg:
+		abi := s.contracts[positional[0]]
+		if abi == nil {
+			return nil
+		}
+		var names []string
+		if kind == actionArg {
+			for _, action := range abi.Actions {
+				names = append(names, action.Name)
+			}
+		} else {
+			for _, table := range abi.Tables {
+				names = append(names, table.Name)
+			}
+		}
+		return names
+	case fileArg:
+		matches, _ := filepath.Glob(current + "*")
+		for i, match := range matches {
+			if info, err := os.Stat(match); err == nil && info.IsDir() {
+				matches[i] = match + string(filepath.Separator)
+			}
+		}
+		return matches
+	}
+	return nil
+}
+
+func commonPrefix(words []string) string {
+	prefix := words[0]
+	for _, word := range words[1:] {
+		for !strings.HasPrefix(word, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
+// complete completes the argument before pos in line, matches are returned
+// if there is more than one and the argument can not be extended
+func (s *session) complete(line string, pos int) (string, int, []string) {
+	prefix := line[:pos]
+	args, err := splitArgs(prefix)
+	if err != nil {
+		return line, pos, nil
+	}
+	current := ""
+	if len(args) > 0 && !strings.HasSuffix(prefix, " ") {
+		current = args[len(args)-1]
+		args = args[:len(args)-1]
+		// quoted or escaped arguments are not completed
+		if !strings.HasSuffix(prefix, current) {
+			return line, pos, nil
+		}
+	}
+
+	var matches []string
+	for _, candidate := range s.candidates(args, current) {
+		if strings.HasPrefix(candidate, current) {
+			matches = append(matches, candidate)
+		}
+	}
+	sort.Strings(matches)
+	if len(matches) == 0 {
+		return line, pos, nil
+	}
+
+	completion := commonPrefix(matches)
+	if len(matches) == 1 && !strings.HasSuffix(completion, string(filepath.Separator)) {
+		completion += " "
+	}
+	if completion == current {
+		if len(matches) == 1 {
+			return line, pos, nil
+		}
+		return line, pos, matches
+	}
+	newPrefix := prefix[:len(prefix)-len(current)] + completion
+	return newPrefix + line[pos:], len(newPrefix), nil
+}
+
+func packageName(file string) string {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return "main"
+	}
+	name := filepath.Base(filepath.Dir(abs))
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_') {
+			return "main"
+		}
+	}
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		return "main"
+	}
+	return name
+}
+
+// exportTest returns a Go test which runs the commands of the session which changed the chain
+func (s *session) exportTest(pkg string) ([]byte, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "// Generated by chaintester repl.\n\npackage %s\n\n", pkg)
+	b.WriteString("import (\n\t\"testing\"\n\n\t\"github.com/uuosio/chaintester\"\n)\n\n")
+	b.WriteString("func TestSession(t *testing.T) {\n")
+	b.WriteString("tester := chaintester.NewChainTester()\ndefer tester.FreeChain()\n\n")
+	if s.fixture != "" {
+		fmt.Fprintf(&b, "if _, err := chaintester.LoadFixture(tester, %q); err != nil {\n\tt.Fatal(err)\n}\n\n", s.fixture)
+	}
+	for _, statement := range s.statements {
+		b.WriteString(statement)
+		b.WriteString("\n")
+	}
+	b.WriteString("}\n")
+	return format.Source([]byte(b.String()))
+}
+
+func (s *session) export(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(s.log)
+	pkg := flags.String("package", "", "package name, derived from the directory of the file by default")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("usage: export [-package name] <file>")
+	}
+	file := flags.Arg(0)
+	if *pkg == "" {
+		*pkg = packageName(file)
+	}
+
+	src, err := s.exportTest(*pkg)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(file, src, 0644); err != nil {
+		return err
+	}
+	fmt.Fprintf(s.log, "exported %d commands to %s\n", len(s.statements), file)
+	return nil
+}
+
+func (s *session) execute(inv *invocation) (err error) {
+	// the client panics on IPC errors, which should not end the session
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return execute(s.opts, s.tester, inv, s.out, s.log)
+}
+
+func (s *session) runCommand(args []string) {
+	inv, err := parseCommand(args[0], args[1:], s.log)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fmt.Fprintln(s.log, "chaintester:", err)
+		return
+	}
+
+	err = s.execute(inv)
+	if inv.code != nil {
+		if code := inv.code(err); code != "" {
+			s.statements = append(s.statements, code)
+		}
+	}
+	if err != nil {
+		reportError(s.opts, s.out, s.log, err)
+		return
+	}
+	if inv.account != "" {
+		s.addAccount(inv.account)
+	}
+	if inv.contract != "" {
+		s.addContract(inv.contract, inv.abiFile)
+	}
+}
+
+// handle handles a line and returns false if the session ends
+func (s *session) handle(line string) bool {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return true
+	}
+	s.history = append(s.history, line)
+
+	args, err := splitArgs(line)
+	if err != nil {
+		fmt.Fprintln(s.log, "chaintester:", err)
+		return true
+	}
+
+	switch args[0] {
+	case "exit", "quit":
+		return false
+	case "help":
+		fmt.Fprint(s.out, replHelp)
+	case "history":
+		for i, line := range s.history {
+			fmt.Fprintf(s.out, "%4d  %s\n", i+1, line)
+		}
+	case "export":
+		if err := s.export(args[1:]); err != nil {
+			fmt.Fprintln(s.log, "chaintester:", err)
+		}
+	default:
+		s.runCommand(args)
+	}
+	return true
+}
+
+// saveHistory appends the commands of the session to a history file, which can be replayed
+// with chaintester repl < file
+func (s *session) saveHistory(path string) error {
+	if path == "" || len(s.history) == 0 {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.WriteString(strings.Join(s.history, "\n") + "\n")
+	return err
+}
+
+// maxHistory is the number of lines of the history file loaded into the terminal,
+// which is the size of the history ring of term.Terminal
+const maxHistory = 100
+
+// loadHistory returns the last lines of the history file
+func loadHistory(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) > maxHistory {
+		lines = lines[len(lines)-maxHistory:]
+	}
+	return lines, nil
+}
+
+// terminalIO feeds the terminal with pending input before reading from in,
+// output is dropped while quiet
+type terminalIO struct {
+	pending io.Reader
+	in      io.Reader
+	out     io.Writer
+	quiet   bool
+}
+
+func (t *terminalIO) Read(p []byte) (int, error) {
+	if t.pending != nil {
+		n, err := t.pending.Read(p)
+		if n > 0 || err != io.EOF {
+			return n, err
+		}
+		t.pending = nil
+	}
+	return t.in.Read(p)
+}
+
+func (t *terminalIO) Write(p []byte) (int, error) {
+	if t.quiet {
+		return len(p), nil
+	}
+	return t.out.Write(p)
+}
+
+// preloadHistory adds lines to the history of terminal, which has no other way
+// to set it than reading the lines as input
+func preloadHistory(terminal *term.Terminal, rw *terminalIO, lines []string) error {
+	if len(lines) == 0 {
+		return nil
+	}
+	rw.pending = strings.NewReader(strings.Join(lines, "\r") + "\r")
+	rw.quiet = true
+	defer func() { rw.quiet = false }()
+	for range lines {
+		if _, err := terminal.ReadLine(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func defaultHistoryFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".chaintester_history")
+}
+
+// runREPL reads commands from the terminal, or line by line from stdin if it is not a terminal
+func runREPL(opts *options, args []string) error {
+	flags := flag.NewFlagSet("repl", flag.ContinueOnError)
+	history := flags.String("history", defaultHistoryFile(), "file the commands of the session are appended to and loaded from, empty to disable")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "usage: chaintester repl [-history file]\n\n%s\n", replDescription)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	tester, free, err := connect(opts)
+	if err != nil {
+		return err
+	}
+	defer free()
+
+	fd := int(os.Stdin.Fd())
+	interactive := term.IsTerminal(fd)
+
+	var s *session
+	var readLine func() (string, error)
+	if interactive {
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return err
+		}
+		defer term.Restore(fd, state)
+
+		rw := &terminalIO{in: os.Stdin, out: os.Stdout}
+		terminal := term.NewTerminal(rw, "chaintester> ")
+		if width, height, err := term.GetSize(fd); err == nil {
+			terminal.SetSize(width, height)
+		}
+		lines, err := loadHistory(*history)
+		if err != nil {
+			return err
+		}
+		if err := preloadHistory(terminal, rw, lines); err != nil {
+			return err
+		}
+		s = newSession(opts, tester, terminal, terminal)
+		terminal.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+			if key != '\t' {
+				return "", 0, false
+			}
+			newLine, newPos, matches := s.complete(line, pos)
+			if len(matches) > 0 {
+				fmt.Fprintln(terminal, strings.Join(matches, "  "))
+			}
+			return newLine, newPos, true
+		}
+		readLine = terminal.ReadLine
+		fmt.Fprintf(terminal, "chain %d, type help for commands\n", tester.ID())
+	} else {
+		s = newSession(opts, tester, os.Stdout, os.Stderr)
+		scanner := bufio.NewScanner(os.Stdin)
+		scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+		readLine = func() (string, error) {
+			if scanner.Scan() {
+				return scanner.Text(), nil
+			}
+			if err := scanner.Err(); err != nil {
+				return "", err
+			}
+			return "", io.EOF
+		}
+	}
+
+	if opts.fixture != "" {
+		if err := s.loadFixture(opts.fixture); err != nil {
+			return err
+		}
+	}
+
+	for {
+		line, err := readLine()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if !s.handle(line) {
+			break
+		}
+	}
+	return s.saveHistory(*history)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/term"
+)
+
+func TestSplitArgs(t *testing.T) {
+	for line, expected := range map[string][]string{
+		"":                                {},
+		"  push  hello inc ":              {"push", "hello", "inc"},
+		`push hello inc '{"name": "go"}'`: {"push", "hello", "inc", `{"name": "go"}`},
+		`push hello inc "a \"b\" c"`:      {"push", "hello", "inc", `a "b" c`},
+		`a\ b '' c`:                       {"a b", "", "c"},
+	} {
+		args, err := splitArgs(line)
+		if err != nil {
+			t.Errorf("splitArgs(%q): %v", line, err)
+			continue
+		}
+		if len(args) == 0 && len(expected) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(args, expected) {
+			t.Errorf("splitArgs(%q) = %q, expected %q", line, args, expected)
+		}
+	}
+
+	for _, line := range []string{`push 'a`, `push "a`, `push a\`} {
+		if _, err := splitArgs(line); err == nil {
+			t.Errorf("splitArgs(%q) succeeded", line)
+		}
+	}
+}
+
+func newTestSession() *session {
+	s := newSession(&options{}, nil, io.Discard, io.Discard)
+	s.addAccount("alice")
+	s.addContract("hello", "../../abigen/testdata/hello.abi")
+	return s
+}
+
+func TestComplete(t *testing.T) {
+	s := newTestSession()
+	for _, test := range []struct {
+		line    string
+		newLine string
+		matches []string
+	}{
+		{"pu", "push ", nil},
+		{"ex", "ex", []string{"exit", "export"}},
+		{"push h", "push hello ", nil},
+		{"push hello ", "push hello ", []string{"assert", "inc", "test"}},
+		{"push hello i", "push hello inc ", nil},
+		{"push -auth a", "push -auth alice ", nil},
+		{"push -auth alice@active h", "push -auth alice@active hello ", nil},
+		{"table hello c", "table hello counter ", nil},
+		{"table -scope ", "table -scope ", []string{"alice", "eosio", "hello"}},
+		{"create-account -cr", "create-account -creator ", nil},
+		{"deploy alice ../../abigen/testdata/h", "deploy alice ../../abigen/testdata/hello.abi ", nil},
+		{"push unknown ", "push unknown ", nil},
+		{"push 'h", "push 'h", nil},
+	} {
+		newLine, newPos, matches := s.complete(test.line, len(test.line))
+		if newLine != test.newLine || newPos != len(test.newLine) || !reflect.DeepEqual(matches, test.matches) {
+			t.Errorf("complete(%q) = %q, %d, %q, expected %q, %q", test.line, newLine, newPos, matches, test.newLine, test.matches)
+		}
+	}
+
+	// the rest of the line is kept
+	newLine, newPos, _ := s.complete("push h {}", 6)
+	if newLine != "push hello  {}" || newPos != 11 {
+		t.Errorf("complete in the middle of a line = %q, %d", newLine, newPos)
+	}
+}
+
+func TestExport(t *testing.T) {
+	s := newTestSession()
+	s.fixture = "fixture.yaml"
+	s.statements = []string{"tester.ProduceBlock()"}
+
+	src, err := s.exportTest("hello_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), "session_test.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.Name.Name != "hello_test" {
+		t.Errorf("package %s, expected hello_test", file.Name.Name)
+	}
+	for _, expected := range []string{"func TestSession(t *testing.T)", `chaintester.LoadFixture(tester, "fixture.yaml")`, "\ttester.ProduceBlock()\n"} {
+		if !strings.Contains(string(src), expected) {
+			t.Errorf("%q not found in\n%s", expected, src)
+		}
+	}
+
+	if name := packageName("/tmp/1abc/session_test.go"); name != "main" {
+		t.Errorf("packageName = %s, expected main", name)
+	}
+	if name := packageName("/tmp/hello/session_test.go"); name != "hello" {
+		t.Errorf("packageName = %s, expected hello", name)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history")
+	if lines, err := loadHistory(path); err != nil || len(lines) != 0 {
+		t.Fatalf("history of a missing file: %v, %v", lines, err)
+	}
+
+	var b strings.Builder
+	for i := 0; i < maxHistory+10; i++ {
+		fmt.Fprintf(&b, "push hello inc %d\n\n", i)
+	}
+	if err := os.WriteFile(path, []byte(b.String()), 0600); err != nil {
+		t.Fatal(err)
+	}
+	lines, err := loadHistory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != maxHistory || lines[0] != "push hello inc 10" {
+		t.Fatalf("bad history: %d lines, first %q", len(lines), lines[0])
+	}
+
+	// up twice selects the line before the last one
+	var out bytes.Buffer
+	rw := &terminalIO{in: strings.NewReader("\x1b[A\x1b[A\r"), out: &out}
+	terminal := term.NewTerminal(rw, "> ")
+	if err := preloadHistory(terminal, rw, lines); err != nil {
+		t.Fatal(err)
+	}
+	if out.Len() != 0 {
+		t.Fatalf("history is echoed: %q", out.String())
+	}
+	line, err := terminal.ReadLine()
+	if err != nil || line != fmt.Sprintf("push hello inc %d", maxHistory+8) {
+		t.Fatalf("line %q, %v", line, err)
+	}
+}
@@ -0,0 +1,304 @@
+package chaintester
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/uuosio/chaintester/interfaces"
+)
+
+// ActionConsole is the console output printed by one receiver of an action.
+type ActionConsole struct {
+	Receiver string
+	Account  string
+	Action   string
+	Console  string
+}
+
+// ConsoleLogger receives console output as it is printed, testing.T satisfies it.
+type ConsoleLogger interface {
+	Log(args ...interface{})
+}
+
+type consoleActionTrace struct {
+	Receiver string `json:"receiver"`
+	Act      struct {
+		Account string `json:"account"`
+		Name    string `json:"name"`
+	} `json:"act"`
+	Console string `json:"console"`
+}
+
+type consoleTransactionTrace struct {
+	ActionTraces []consoleActionTrace `json:"action_traces"`
+}
+
+func parseConsole(trace []byte) []ActionConsole {
+	var trx consoleTransactionTrace
+	if err := json.Unmarshal(trace, &trx); err != nil {
+		return nil
+	}
+
+	consoles := make([]ActionConsole, 0, len(trx.ActionTraces))
+	for _, act := range trx.ActionTraces {
+		consoles = append(consoles, ActionConsole{
+			Receiver: act.Receiver,
+			Account:  act.Act.Account,
+			Action:   act.Act.Name,
+			Console:  act.Console,
+		})
+	}
+	return consoles
+}
+
+// GetConsole returns the console output of every action and receiver in a transaction trace
+func GetConsole(trace *JsonValue) []ActionConsole {
+	if trace == nil {
+		return nil
+	}
+	return trace.Console()
+}
+
+// Console returns the console output of every action and receiver in a transaction trace,
+// the trace returned by a push also has the output of native contracts
+func (b *JsonValue) Console() []ActionConsole {
+	if b.console != nil {
+		return b.console
+	}
+	return parseConsole(b.raw)
+}
+
+// nativeConsole collects the output printed by a native apply function
+type nativeConsole struct {
+	tester  *ChainTester
+	console ActionConsole
+	pending string
+}
+
+var g_NativeConsole *nativeConsole
+
+func beginNativeConsole(chainTesterId int32, receiver uint64, firstReceiver uint64, action uint64) {
+	tester, ok := g_ChainTesters[chainTesterId]
+	if !ok {
+		g_NativeConsole = nil
+		return
+	}
+
+	g_NativeConsole = &nativeConsole{
+		tester: tester,
+		console: ActionConsole{
+			Receiver: N2S(receiver),
+			Account:  N2S(firstReceiver),
+			Action:   N2S(action),
+		},
+	}
+}
+
+func endNativeConsole() {
+	c := g_NativeConsole
+	if c == nil {
+		return
+	}
+	g_NativeConsole = nil
+
+	c.flush(true)
+	c.tester.nativeConsole = append(c.tester.nativeConsole, c.console)
+}
+
+func (c *nativeConsole) write(s string) {
+	c.console.Console += s
+	if c.tester.consoleLogger == nil {
+		return
+	}
+	c.pending += s
+	c.flush(false)
+}
+
+func (c *nativeConsole) flush(all bool) {
+	logger := c.tester.consoleLogger
+	if logger == nil {
+		return
+	}
+
+	for {
+		i := strings.IndexByte(c.pending, '\n')
+		if i < 0 {
+			break
+		}
+		logger.Log(fmt.Sprintf("[%s] %s", c.console.Receiver, c.pending[:i]))
+		c.pending = c.pending[i+1:]
+	}
+
+	if all && c.pending != "" {
+		logger.Log(fmt.Sprintf("[%s] %s", c.console.Receiver, c.pending))
+		c.pending = ""
+	}
+}
+
+// onVMAPICall captures the output of the print functions called by a native apply
+func onVMAPICall(method string, args thrift.TStruct) {
+	if g_NativeConsole == nil {
+		return
+	}
+
+	if s, ok := formatPrintArgs(args); ok {
+		g_NativeConsole.write(s)
+	}
+}
+
+func formatInt128(value []byte, signed bool) string {
+	if len(value) != 16 {
+		return hex.EncodeToString(value)
+	}
+
+	be := make([]byte, 16)
+	for i := range value {
+		be[15-i] = value[i]
+	}
+
+	n := new(big.Int).SetBytes(be)
+	if signed && value[15]&0x80 != 0 {
+		n.Sub(n, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+	return n.String()
+}
+
+// formatFloat128 converts an IEEE 754 quadruple precision float to the nearest float64
+func formatFloat128(value []byte) string {
+	if len(value) != 16 {
+		return hex.EncodeToString(value)
+	}
+
+	lo := binary.LittleEndian.Uint64(value[:8])
+	hi := binary.LittleEndian.Uint64(value[8:])
+	sign := hi >> 63
+	exp := int64((hi >> 48) & 0x7fff)
+	mantissa := (hi&0xffffffffffff)<<4 | lo>>60
+
+	var f float64
+	switch {
+	case exp == 0:
+		f = 0
+	case exp == 0x7fff:
+		if mantissa != 0 {
+			f = math.NaN()
+		} else {
+			f = math.Inf(1)
+		}
+	default:
+		exp = exp - 16383 + 1023
+		if exp >= 0x7ff {
+			f = math.Inf(1)
+		} else if exp <= 0 {
+			f = 0
+		} else {
+			f = math.Float64frombits(uint64(exp)<<52 | mantissa)
+		}
+	}
+
+	if sign != 0 {
+		f = -f
+	}
+	return strconv.FormatFloat(f, 'e', 17, 64)
+}
+
+func formatPrintArgs(args thrift.TStruct) (string, bool) {
+	switch v := args.(type) {
+	case *interfaces.ApplyPrintsArgs:
+		return v.Cstr, true
+	case *interfaces.ApplyPrintsLArgs:
+		return string(v.Cstr), true
+	case *interfaces.ApplyPrintiArgs:
+		return strconv.FormatInt(v.N, 10), true
+	case *interfaces.ApplyPrintuiArgs:
+		return strconv.FormatUint(getUint64(v.N), 10), true
+	case *interfaces.ApplyPrinti128Args:
+		return formatInt128(v.Value, true), true
+	case *interfaces.ApplyPrintui128Args:
+		return formatInt128(v.Value, false), true
+	case *interfaces.ApplyPrintsfArgs:
+		if len(v.Value) != 4 {
+			return hex.EncodeToString(v.Value), true
+		}
+		f := math.Float32frombits(binary.LittleEndian.Uint32(v.Value))
+		return strconv.FormatFloat(float64(f), 'e', 6, 32), true
+	case *interfaces.ApplyPrintdfArgs:
+		if len(v.Value) != 8 {
+			return hex.EncodeToString(v.Value), true
+		}
+		f := math.Float64frombits(binary.LittleEndian.Uint64(v.Value))
+		return strconv.FormatFloat(f, 'e', 15, 64), true
+	case *interfaces.ApplyPrintqfArgs:
+		return formatFloat128(v.Value), true
+	case *interfaces.ApplyPrintnArgs:
+		return N2S(getUint64(v.Name)), true
+	case *interfaces.ApplyPrinthexArgs:
+		return hex.EncodeToString(v.Data), true
+	}
+	return "", false
+}
+
+// mergeConsole fills the console of native receivers from the output captured during native apply,
+// the trace may not include it if the native apply failed
+func mergeConsole(consoles []ActionConsole, native []ActionConsole) []ActionConsole {
+	used := make([]bool, len(native))
+	for i := range consoles {
+		for j := range native {
+			if used[j] {
+				continue
+			}
+			if native[j].Receiver == consoles[i].Receiver && native[j].Account == consoles[i].Account && native[j].Action == consoles[i].Action {
+				used[j] = true
+				if consoles[i].Console == "" {
+					consoles[i].Console = native[j].Console
+				}
+				break
+			}
+		}
+	}
+
+	for j := range native {
+		if !used[j] {
+			consoles = append(consoles, native[j])
+		}
+	}
+	return consoles
+}
+
+func (p *ChainTester) collectConsole(trace []byte) {
+	native := p.nativeConsole
+	p.nativeConsole = nil
+	p.console = mergeConsole(parseConsole(trace), native)
+
+	if p.consoleLogger == nil {
+		return
+	}
+
+	// output of native apply has been logged as it was printed
+	applyMap := g_ChainTesterApplyMap[p.id]
+	for _, c := range p.console {
+		if _, ok := applyMap[c.Receiver]; ok || c.Console == "" {
+			continue
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(c.Console, "\n"), "\n") {
+			p.consoleLogger.Log(fmt.Sprintf("[%s] %s", c.Receiver, line))
+		}
+	}
+}
+
+// Console returns the console output of every action and receiver in the last pushed transaction
+func (p *ChainTester) Console() []ActionConsole {
+	return p.console
+}
+
+// SetConsoleLogger streams console output to logger as it is printed, pass nil to disable it
+func (p *ChainTester) SetConsoleLogger(logger ConsoleLogger) {
+	p.consoleLogger = logger
+}
@@ -0,0 +1,99 @@
+package chaintester
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/uuosio/chaintester/interfaces"
+)
+
+func TestParseConsole(t *testing.T) {
+	trace := []byte(`{
+		"id": "aa",
+		"action_traces": [
+			{"receiver": "hello", "act": {"account": "hello", "name": "inc"}, "console": "count: 1\n"},
+			{"receiver": "alice", "act": {"account": "hello", "name": "inc"}, "console": ""}
+		]
+	}`)
+
+	consoles := parseConsole(trace)
+	if len(consoles) != 2 {
+		t.Fatalf("expected 2 consoles, got %d", len(consoles))
+	}
+	if consoles[0].Receiver != "hello" || consoles[0].Action != "inc" || consoles[0].Console != "count: 1\n" {
+		t.Fatalf("bad console: %+v", consoles[0])
+	}
+
+	native := []ActionConsole{
+		{Receiver: "alice", Account: "hello", Action: "inc", Console: "notified\n"},
+		{Receiver: "bob", Account: "hello", Action: "inc", Console: "failed\n"},
+	}
+	consoles = mergeConsole(consoles, native)
+	if len(consoles) != 3 {
+		t.Fatalf("expected 3 consoles, got %d", len(consoles))
+	}
+	if consoles[1].Console != "notified\n" || consoles[2].Receiver != "bob" {
+		t.Fatalf("bad merged console: %+v", consoles)
+	}
+}
+
+func TestTraceConsole(t *testing.T) {
+	tester := &ChainTester{}
+	tester.nativeConsole = []ActionConsole{{Receiver: "alice", Account: "hello", Action: "inc", Console: "notified\n"}}
+	value, err := tester.parseTrace([]byte(`{"action_traces": [
+		{"receiver": "hello", "act": {"account": "hello", "name": "inc"}, "console": "count: 1\n"},
+		{"receiver": "alice", "act": {"account": "hello", "name": "inc"}, "console": ""}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if consoles := value.Console(); len(consoles) != 2 || consoles[0].Console != "count: 1\n" || consoles[1].Console != "notified\n" {
+		t.Errorf("console of trace %+v", consoles)
+	}
+
+	tester.nativeConsole = []ActionConsole{{Receiver: "hello", Account: "hello", Action: "inc", Console: "failed\n"}}
+	_, err = tester.parseTrace([]byte(`{"except": {"code": 3050003}}`))
+	if consoles := err.(*TransactionError).Console(); len(consoles) != 1 || consoles[0].Console != "failed\n" {
+		t.Errorf("console of error %+v", consoles)
+	}
+}
+
+func TestFormatPrintArgs(t *testing.T) {
+	n := &interfaces.Uint64{RawValue: make([]byte, 8)}
+	binary.LittleEndian.PutUint64(n.RawValue, 0x5530ea0000000000)
+	i128 := make([]byte, 16)
+	for i := range i128 {
+		i128[i] = 0xff
+	}
+	df := make([]byte, 8)
+	binary.LittleEndian.PutUint64(df, math.Float64bits(1.5))
+	qf := make([]byte, 16)
+	binary.LittleEndian.PutUint64(qf[8:], 0x3fff800000000000)
+
+	cases := []struct {
+		args     thrift.TStruct
+		expected string
+	}{
+		{&interfaces.ApplyPrintsArgs{Cstr: "hello"}, "hello"},
+		{&interfaces.ApplyPrintiArgs{N: -3}, "-3"},
+		{&interfaces.ApplyPrintnArgs{Name: n}, "eosio"},
+		{&interfaces.ApplyPrinti128Args{Value: i128}, "-1"},
+		{&interfaces.ApplyPrintui128Args{Value: i128}, "340282366920938463463374607431768211455"},
+		{&interfaces.ApplyPrintdfArgs{Value: df}, "1.500000000000000e+00"},
+		{&interfaces.ApplyPrintqfArgs{Value: qf}, "1.50000000000000000e+00"},
+		{&interfaces.ApplyPrinthexArgs{Data: []byte{0xab, 0x01}}, "ab01"},
+	}
+
+	for _, c := range cases {
+		s, ok := formatPrintArgs(c.args)
+		if !ok || s != c.expected {
+			t.Errorf("%T: expected %q, got %q", c.args, c.expected, s)
+		}
+	}
+
+	if _, ok := formatPrintArgs(&interfaces.ApplyRequireAuthArgs{}); ok {
+		t.Errorf("require_auth is not a print function")
+	}
+}
@@ -0,0 +1,145 @@
+package chaintester
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/uuosio/chaintester/interfaces"
+)
+
+// MaxDeferredDrainBlocks is the maximum number of blocks produced by
+// ProduceBlockUntilDeferredDrained, deferred transactions which keep
+// scheduling new ones would never be drained otherwise
+const MaxDeferredDrainBlocks = 1000
+
+// DeferredTransaction is a transaction in the generated transaction queue,
+// scheduled by SendDeferred or by a transaction with delay
+type DeferredTransaction struct {
+	TrxID  string
+	Sender string
+	// uint128 in decimal
+	SenderID   string
+	Payer      string
+	DelayUntil time.Time
+	Expiration time.Time
+	Published  time.Time
+	PackedTrx  []byte
+}
+
+type deferredTransaction struct {
+	TrxID      string `json:"trx_id"`
+	Sender     string `json:"sender"`
+	SenderID   string `json:"sender_id"`
+	Payer      string `json:"payer"`
+	DelayUntil string `json:"delay_until"`
+	Expiration string `json:"expiration"`
+	Published  string `json:"published"`
+	PackedTrx  string `json:"packed_trx"`
+}
+
+func parseDeferredTransactions(data []byte) ([]*DeferredTransaction, error) {
+	var transactions []deferredTransaction
+	if err := json.Unmarshal(data, &transactions); err != nil {
+		return nil, newErrorf("%s", string(data))
+	}
+
+	parseTime := func(trx *deferredTransaction, key string, value string) (time.Time, error) {
+		t, err := time.Parse("2006-01-02T15:04:05", value)
+		if err != nil {
+			return time.Time{}, newErrorf("deferred transaction %s: %s: %v", trx.TrxID, key, err)
+		}
+		return t, nil
+	}
+
+	ret := make([]*DeferredTransaction, 0, len(transactions))
+	for i := range transactions {
+		trx := &transactions[i]
+		deferred := &DeferredTransaction{
+			TrxID:    trx.TrxID,
+			Sender:   trx.Sender,
+			SenderID: trx.SenderID,
+			Payer:    trx.Payer,
+		}
+
+		var err error
+		if deferred.DelayUntil, err = parseTime(trx, "delay_until", trx.DelayUntil); err != nil {
+			return nil, err
+		}
+		if deferred.Expiration, err = parseTime(trx, "expiration", trx.Expiration); err != nil {
+			return nil, err
+		}
+		if deferred.Published, err = parseTime(trx, "published", trx.Published); err != nil {
+			return nil, err
+		}
+		if deferred.PackedTrx, err = hex.DecodeString(trx.PackedTrx); err != nil {
+			return nil, newErrorf("deferred transaction %s: packed_trx: %v", trx.TrxID, err)
+		}
+		ret = append(ret, deferred)
+	}
+
+	// transactions with the same delay_until keep the order of the table, which is the order of their ids
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].DelayUntil.Before(ret[j].DelayUntil)
+	})
+	return ret, nil
+}
+
+// ListDeferred returns the scheduled transactions ordered by delay_until, then by the order they were scheduled
+func (p *ChainTester) ListDeferred() ([]*DeferredTransaction, error) {
+	ret, err := p.IPCChainTesterClient.GetScheduledTransactions(defaultCtx, p.id)
+	if err != nil {
+		return nil, err
+	}
+	return parseDeferredTransactions([]byte(ret))
+}
+
+// ExecuteDeferred executes a scheduled transaction immediately, even if its delay_until has not been reached
+func (p *ChainTester) ExecuteDeferred(trxID string) (*JsonValue, error) {
+	var _args interfaces.IPCChainTesterExecuteDeferredArgs
+	_args.ID = p.id
+	_args.TrxID = trxID
+	var _result interfaces.IPCChainTesterExecuteDeferredResult
+	var _meta thrift.ResponseMeta
+
+	var _err error
+	_meta, _err = p.Call(defaultCtx, "execute_deferred", &_args, &_result)
+	p.SetLastResponseMeta_(_meta)
+	if _err != nil {
+		return nil, _err
+	}
+	return p.parseTrace(_result.GetSuccess())
+}
+
+// ProduceBlockUntilDeferredDrained produces blocks until the generated transaction
+// queue is empty. Blocks are produced at the delay_until of the next scheduled
+// transaction, so delayed transactions run without waiting block by block.
+func (p *ChainTester) ProduceBlockUntilDeferredDrained() error {
+	for i := 0; i < MaxDeferredDrainBlocks; i++ {
+		deferred, err := p.ListDeferred()
+		if err != nil {
+			return err
+		}
+		if len(deferred) == 0 {
+			return nil
+		}
+
+		next := deferred[0].DelayUntil
+
+		info, err := p.GetChainInfo()
+		if err != nil {
+			return err
+		}
+		if next.After(info.HeadBlockTime.Add(BlockInterval)) {
+			err = p.setBlockTime(next)
+		} else {
+			err = p.ProduceBlock()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return newErrorf("deferred transactions are not drained after %d blocks", MaxDeferredDrainBlocks)
+}
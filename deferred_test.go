@@ -0,0 +1,56 @@
+package chaintester
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDeferredTransactions(t *testing.T) {
+	data := `[{
+		"trx_id": "b6e2c5d8bd2c2a3a3f3b7b5b7f0e0d1c9c9a8a7a6a5a4a3a2a1a0a9a8a7a6a5a",
+		"sender": "hello",
+		"sender_id": "340282366920938463463374607431768211455",
+		"payer": "alice",
+		"delay_until": "2022-01-01T00:00:03.500",
+		"expiration": "2022-01-01T00:10:03.500",
+		"published": "2022-01-01T00:00:00.000",
+		"packed_trx": "0a0b"
+	}]`
+	deferred, err := parseDeferredTransactions([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deferred) != 1 {
+		t.Fatalf("bad deferred transactions: %v", deferred)
+	}
+
+	trx := deferred[0]
+	if trx.Sender != "hello" || trx.Payer != "alice" || trx.SenderID != "340282366920938463463374607431768211455" {
+		t.Fatalf("bad deferred transaction: %+v", trx)
+	}
+	if !trx.DelayUntil.Equal(time.Date(2022, 1, 1, 0, 0, 3, 500*int(time.Millisecond), time.UTC)) {
+		t.Fatalf("bad delay_until: %v", trx.DelayUntil)
+	}
+	if len(trx.PackedTrx) != 2 || trx.PackedTrx[0] != 0x0a {
+		t.Fatalf("bad packed_trx: %x", trx.PackedTrx)
+	}
+
+	data = `[
+		{"trx_id": "a", "delay_until": "2022-01-01T00:00:05.000", "expiration": "2022-01-01T00:10:00.000", "published": "2022-01-01T00:00:00.000", "packed_trx": ""},
+		{"trx_id": "b", "delay_until": "2022-01-01T00:00:03.000", "expiration": "2022-01-01T00:10:00.000", "published": "2022-01-01T00:00:00.000", "packed_trx": ""},
+		{"trx_id": "c", "delay_until": "2022-01-01T00:00:05.000", "expiration": "2022-01-01T00:10:00.000", "published": "2022-01-01T00:00:00.000", "packed_trx": ""}
+	]`
+	deferred, err = parseDeferredTransactions([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deferred) != 3 || deferred[0].TrxID != "b" || deferred[1].TrxID != "a" || deferred[2].TrxID != "c" {
+		t.Fatalf("deferred transactions are not ordered by delay_until: %v %v %v", deferred[0], deferred[1], deferred[2])
+	}
+
+	for _, data := range []string{`{"except": "error"}`, `[{"delay_until": "now"}]`} {
+		if _, err := parseDeferredTransactions([]byte(data)); err == nil {
+			t.Errorf("%s should fail to parse", data)
+		}
+	}
+}
@@ -32,3 +32,51 @@ func N2S(value uint64) string {
 	}
 	return string(str[:i+1])
 }
+
+func char2value(c byte) uint64 {
+	switch {
+	case c >= 'a' && c <= 'z':
+		return uint64(c-'a') + 6
+	case c >= '1' && c <= '5':
+		return uint64(c-'1') + 1
+	}
+	return 0
+}
+
+func S2N(s string) uint64 {
+	var value uint64
+	n := len(s)
+	if n > 13 {
+		n = 13
+	}
+
+	for i := 0; i < n; i++ {
+		var c uint64
+		if i < 12 {
+			c = char2value(s[i]) & 0x1f
+			value |= c << (64 - 5*(i+1))
+		} else {
+			c = char2value(s[i]) & 0x0f
+			value |= c
+		}
+	}
+	return value
+}
+
+// IsValidName returns true if s is a valid account or action name
+func IsValidName(s string) bool {
+	if len(s) > 13 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '.' || (c >= 'a' && c <= 'z') || (c >= '1' && c <= '5') {
+			continue
+		}
+		return false
+	}
+	if len(s) == 13 && char2value(s[12]) > 0x0f {
+		return false
+	}
+	return N2S(S2N(s)) == s
+}
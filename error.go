@@ -16,6 +16,8 @@ func NewAssertError(err error) *AssertError {
 
 type TransactionError struct {
 	Err []byte
+
+	console []ActionConsole
 }
 
 func (t *TransactionError) Error() string {
@@ -24,7 +26,6 @@ func (t *TransactionError) Error() string {
 
 func (t *TransactionError) Json() *JsonValue {
 	value := &JsonValue{}
-	// fmt.Printf("++++++push_action return: %v", string(ret))
 	err := json.Unmarshal(t.Err, value)
 	if err != nil {
 		return nil
@@ -32,6 +33,38 @@ func (t *TransactionError) Json() *JsonValue {
 	return value
 }
 
+// Console returns the console output printed before the transaction failed,
+// including the output of native contracts if the error was returned by a push
+func (t *TransactionError) Console() []ActionConsole {
+	if t.console != nil {
+		return t.console
+	}
+	return parseConsole(t.Err)
+}
+
+// AssertMessage returns the message of the failed assertion, or the message of the exception
+// if the transaction failed for another reason
+func (t *TransactionError) AssertMessage() string {
+	var trace struct {
+		Except struct {
+			Message string `json:"message"`
+			Stack   []struct {
+				Data map[string]interface{} `json:"data"`
+			} `json:"stack"`
+		} `json:"except"`
+	}
+	if err := json.Unmarshal(t.Err, &trace); err != nil {
+		return string(t.Err)
+	}
+
+	for _, stack := range trace.Except.Stack {
+		if s, ok := stack.Data["s"].(string); ok {
+			return s
+		}
+	}
+	return trace.Except.Message
+}
+
 func NewTransactionError(value []byte) *TransactionError {
-	return &TransactionError{value}
+	return &TransactionError{Err: value}
 }
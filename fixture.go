@@ -0,0 +1,405 @@
+package chaintester
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/uuosio/chaintester/abigen"
+	"github.com/uuosio/chaintester/interfaces"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultFixtureRAMBytes = 10 * 1024 * 1024
+	fixtureSeedAction      = "fixture"
+)
+
+type FixtureAccount struct {
+	Name    string `yaml:"name"`
+	Creator string `yaml:"creator"`
+	// a key is created if public_key is empty
+	PublicKey  string `yaml:"public_key"`
+	PrivateKey string `yaml:"private_key"`
+	RAMBytes   int64  `yaml:"ram_bytes"`
+	StakeNet   int64  `yaml:"stake_net"`
+	StakeCPU   int64  `yaml:"stake_cpu"`
+	// add eosio.code to the active permission
+	CodePermission bool `yaml:"code_permission"`
+}
+
+type FixtureContract struct {
+	Account string `yaml:"account"`
+	// paths are relative to the fixture file
+	Wasm string `yaml:"wasm"`
+	Abi  string `yaml:"abi"`
+}
+
+type FixtureBalance struct {
+	Account  string `yaml:"account"`
+	Quantity string `yaml:"quantity"`
+	// defaults to eosio.token
+	Contract string `yaml:"contract"`
+	// defaults to eosio
+	Issuer string `yaml:"issuer"`
+}
+
+// FixtureRow is a row stored directly into a table of a deployed contract,
+// secondary indexes of the table are not updated.
+type FixtureRow struct {
+	Code       string `yaml:"code"`
+	Scope      string `yaml:"scope"`
+	Table      string `yaml:"table"`
+	Payer      string `yaml:"payer"`
+	PrimaryKey uint64 `yaml:"primary_key"`
+	// the row as an object packed with the type of the table in the abi,
+	// or a hex encoded packed row
+	Data interface{} `yaml:"data"`
+	// path of the abi which packs data, defaults to the abi of code in contracts
+	Abi string `yaml:"abi"`
+}
+
+type Fixture struct {
+	Accounts  []FixtureAccount  `yaml:"accounts"`
+	Contracts []FixtureContract `yaml:"contracts"`
+	Balances  []FixtureBalance  `yaml:"balances"`
+	Rows      []FixtureRow      `yaml:"rows"`
+
+	dir string
+}
+
+// ParseFixture parses a fixture in YAML or JSON format
+func ParseFixture(data []byte) (*Fixture, error) {
+	fixture := &Fixture{}
+	if err := yaml.Unmarshal(data, fixture); err != nil {
+		return nil, newErrorf("parse fixture: %v", err)
+	}
+
+	if err := fixture.validate(); err != nil {
+		return nil, err
+	}
+	return fixture, nil
+}
+
+func (f *Fixture) validate() error {
+	for i := range f.Accounts {
+		account := &f.Accounts[i]
+		if account.Name == "" || !IsValidName(account.Name) {
+			return newErrorf("invalid account name: %q", account.Name)
+		}
+		if account.Creator == "" {
+			account.Creator = "eosio"
+		}
+		if account.RAMBytes == 0 {
+			account.RAMBytes = defaultFixtureRAMBytes
+		}
+		if account.PrivateKey != "" && account.PublicKey == "" {
+			return newErrorf("account %s: private_key without public_key", account.Name)
+		}
+	}
+
+	for _, contract := range f.Contracts {
+		if contract.Account == "" || !IsValidName(contract.Account) {
+			return newErrorf("invalid contract account: %q", contract.Account)
+		}
+		if contract.Wasm == "" {
+			return newErrorf("contract %s: wasm is required", contract.Account)
+		}
+	}
+
+	for i := range f.Balances {
+		balance := &f.Balances[i]
+		if balance.Contract == "" {
+			balance.Contract = "eosio.token"
+		}
+		if balance.Issuer == "" {
+			balance.Issuer = "eosio"
+		}
+		if balance.Account == "" || !IsValidName(balance.Account) || balance.Quantity == "" {
+			return newErrorf("invalid balance: %+v", *balance)
+		}
+	}
+
+	for i := range f.Rows {
+		row := &f.Rows[i]
+		if row.Scope == "" {
+			row.Scope = row.Code
+		}
+		if row.Payer == "" {
+			row.Payer = row.Code
+		}
+		if row.Code == "" || row.Table == "" || !IsValidName(row.Code) || !IsValidName(row.Scope) || !IsValidName(row.Table) || !IsValidName(row.Payer) {
+			return newErrorf("invalid row: %+v", *row)
+		}
+		switch data := row.Data.(type) {
+		case string:
+			if _, err := hex.DecodeString(data); err != nil {
+				return newErrorf("row %s.%s: invalid data: %v", row.Code, row.Table, err)
+			}
+		case map[string]interface{}:
+		default:
+			return newErrorf("row %s.%s: data must be an object or a hex string", row.Code, row.Table)
+		}
+	}
+	return nil
+}
+
+func (f *Fixture) path(name string) string {
+	if name == "" || filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(f.dir, name)
+}
+
+// LoadFixture reads a fixture file and applies it to the chain. Accounts are
+// created first, then permissions, contracts and balances are pushed in a
+// single PushActions batch and finally rows are stored into their tables.
+func LoadFixture(tester *ChainTester, path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fixture, err := ParseFixture(data)
+	if err != nil {
+		return nil, err
+	}
+	fixture.dir = filepath.Dir(path)
+
+	if err := fixture.Apply(tester); err != nil {
+		return nil, err
+	}
+	return fixture, nil
+}
+
+// Apply applies the fixture to the chain, keys created for accounts are stored back into the fixture
+func (f *Fixture) Apply(tester *ChainTester) error {
+	if err := f.validate(); err != nil {
+		return err
+	}
+	// rows are packed before the chain is changed
+	codes, rowsByCode, err := f.packRows()
+	if err != nil {
+		return err
+	}
+
+	actions := make([]*interfaces.Action, 0, len(f.Accounts)+2*len(f.Contracts)+2*len(f.Balances))
+	for i := range f.Accounts {
+		account := &f.Accounts[i]
+		if err := f.createAccount(tester, account); err != nil {
+			return err
+		}
+
+		if account.CodePermission {
+			auth := NewAuthority(1).AddKey(account.PublicKey, 1).AddAccount(account.Name, "eosio.code", 1)
+			action, err := updateAuthAction(account.Name, "active", "owner", auth)
+			if err != nil {
+				return err
+			}
+			actions = append(actions, action)
+		}
+	}
+
+	for _, contract := range f.Contracts {
+		contractActions, err := tester.deployContractActions(contract.Account, f.path(contract.Wasm), f.path(contract.Abi))
+		if err != nil {
+			return err
+		}
+		actions = append(actions, contractActions...)
+	}
+
+	for _, balance := range f.Balances {
+		issue, err := newAction(balance.Contract, "issue", map[string]string{
+			"to":       balance.Issuer,
+			"quantity": balance.Quantity,
+			"memo":     "fixture",
+		}, PermissionLevel{balance.Issuer, "active"})
+		if err != nil {
+			return err
+		}
+		actions = append(actions, issue)
+
+		if balance.Account == balance.Issuer {
+			continue
+		}
+		transfer, err := newAction(balance.Contract, "transfer", map[string]string{
+			"from":     balance.Issuer,
+			"to":       balance.Account,
+			"quantity": balance.Quantity,
+			"memo":     "fixture",
+		}, PermissionLevel{balance.Issuer, "active"})
+		if err != nil {
+			return err
+		}
+		actions = append(actions, transfer)
+	}
+
+	if len(actions) != 0 {
+		if _, err := tester.PushActions(actions); err != nil {
+			return err
+		}
+	}
+
+	return f.storeRows(tester, codes, rowsByCode)
+}
+
+func (f *Fixture) createAccount(tester *ChainTester, account *FixtureAccount) error {
+	if account.PublicKey == "" {
+		key, err := tester.CreateKey()
+		if err != nil {
+			return err
+		}
+		if account.PublicKey, err = key.GetString("public"); err != nil {
+			return err
+		}
+		if account.PrivateKey, err = key.GetString("private"); err != nil {
+			return err
+		}
+	}
+
+	if account.PrivateKey != "" {
+		if err := tester.ImportKey(account.PublicKey, account.PrivateKey); err != nil {
+			return err
+		}
+	}
+
+	_, err := tester.CreateAccount(account.Creator, account.Name, account.PublicKey, account.PublicKey, account.RAMBytes, account.StakeNet, account.StakeCPU)
+	if err != nil {
+		return newErrorf("create account %s: %v", account.Name, err)
+	}
+	return nil
+}
+
+// fixtureRow is a row of a fixture with its packed data
+type fixtureRow struct {
+	FixtureRow
+	packed []byte
+}
+
+// packRow packs the data of row, objects are packed with the type of the table in the abi of the contract
+func (f *Fixture) packRow(row *FixtureRow, abis map[string]*abigen.ABI) ([]byte, error) {
+	if data, ok := row.Data.(string); ok {
+		return hex.DecodeString(data)
+	}
+
+	path := f.path(row.Abi)
+	if path == "" {
+		for _, contract := range f.Contracts {
+			if contract.Account == row.Code {
+				path = f.path(contract.Abi)
+			}
+		}
+	}
+	if path == "" {
+		return nil, newErrorf("row %s.%s: no abi to pack data, add the abi of %s to contracts or the row", row.Code, row.Table, row.Code)
+	}
+
+	abi, ok := abis[path]
+	if !ok {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if abi, err = abigen.ParseABI(raw); err != nil {
+			return nil, newErrorf("%s: %v", path, err)
+		}
+		abis[path] = abi
+	}
+
+	typ, err := tableType(abi, row.Table)
+	if err != nil {
+		return nil, newErrorf("row %s.%s: %v", row.Code, row.Table, err)
+	}
+
+	// numbers are decoded as json.Number to keep the precision of 64 bit integers
+	raw, err := json.Marshal(row.Data)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	data, err := packJSON(abi, typ, value)
+	if err != nil {
+		return nil, newErrorf("row %s.%s: %v", row.Code, row.Table, err)
+	}
+	return data, nil
+}
+
+// packRows packs the rows of the fixture grouped by contract
+func (f *Fixture) packRows() ([]string, map[string][]fixtureRow, error) {
+	rowsByCode := make(map[string][]fixtureRow)
+	codes := make([]string, 0)
+	abis := make(map[string]*abigen.ABI)
+	for i := range f.Rows {
+		row := &f.Rows[i]
+		packed, err := f.packRow(row, abis)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := rowsByCode[row.Code]; !ok {
+			codes = append(codes, row.Code)
+		}
+		rowsByCode[row.Code] = append(rowsByCode[row.Code], fixtureRow{*row, packed})
+	}
+	return codes, rowsByCode, nil
+}
+
+// storeRows stores rows through a temporary native apply of their contract
+func (f *Fixture) storeRows(tester *ChainTester, codes []string, rowsByCode map[string][]fixtureRow) error {
+	for _, code := range codes {
+		if err := storeRows(tester, code, rowsByCode[code]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func storeRows(tester *ChainTester, code string, rows []fixtureRow) error {
+	prevApply := g_ChainTesterApplyMap[tester.id][code]
+	defer tester.SetNativeApply(code, prevApply)
+
+	tester.SetNativeApply(code, func(receiver uint64, firstReceiver uint64, action uint64) {
+		if action != S2N(fixtureSeedAction) {
+			panic(fmt.Errorf("unexpected action %s while storing fixture rows", N2S(action)))
+		}
+		for _, row := range rows {
+			_, err := GetVMAPI().DbStoreI64(defaultCtx,
+				newUint64(S2N(row.Scope)),
+				newUint64(S2N(row.Table)),
+				newUint64(S2N(row.Payer)),
+				newUint64(row.PrimaryKey),
+				row.packed)
+			if err != nil {
+				panic(err)
+			}
+		}
+	})
+
+	// payers other than the contract have to authorize the action
+	auth := []PermissionLevel{{code, "active"}}
+	payers := map[string]bool{code: true}
+	for _, row := range rows {
+		if !payers[row.Payer] {
+			payers[row.Payer] = true
+			auth = append(auth, PermissionLevel{row.Payer, "active"})
+		}
+	}
+	permissions, err := encodePermissions(auth, false)
+	if err != nil {
+		return err
+	}
+
+	_, err = tester.PushAction(code, fixtureSeedAction, []byte{}, permissions)
+	if err != nil {
+		return newErrorf("store rows of %s: %v", code, err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package chaintester
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseFixture(t *testing.T) {
+	yamlFixture := `
+accounts:
+  - name: alice
+    code_permission: true
+  - name: bob
+    creator: hello
+    ram_bytes: 2048
+contracts:
+  - account: alice
+    wasm: test.wasm
+    abi: test.abi
+balances:
+  - account: bob
+    quantity: "10.0000 EOS"
+rows:
+  - code: alice
+    table: counter
+    primary_key: 1
+    data: "01000000000000000100000000000000"
+`
+	fixture, err := ParseFixture([]byte(yamlFixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(fixture.Accounts) != 2 || fixture.Accounts[0].Creator != "eosio" || fixture.Accounts[0].RAMBytes != defaultFixtureRAMBytes {
+		t.Fatalf("bad accounts: %+v", fixture.Accounts)
+	}
+	if !fixture.Accounts[0].CodePermission || fixture.Accounts[1].RAMBytes != 2048 {
+		t.Fatalf("bad accounts: %+v", fixture.Accounts)
+	}
+	if fixture.Balances[0].Contract != "eosio.token" || fixture.Balances[0].Issuer != "eosio" {
+		t.Fatalf("bad balance: %+v", fixture.Balances[0])
+	}
+	if fixture.Rows[0].Scope != "alice" || fixture.Rows[0].Payer != "alice" {
+		t.Fatalf("bad row: %+v", fixture.Rows[0])
+	}
+
+	jsonFixture := `{"accounts": [{"name": "carol", "stake_net": 100, "stake_cpu": 200}]}`
+	fixture, err = ParseFixture([]byte(jsonFixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fixture.Accounts[0].StakeCPU != 200 {
+		t.Fatalf("bad accounts: %+v", fixture.Accounts)
+	}
+
+	invalid := []string{
+		`{"accounts": [{"name": "Alice"}]}`,
+		`{"contracts": [{"account": "alice"}]}`,
+		`{"balances": [{"account": "alice"}]}`,
+		`{"rows": [{"code": "alice", "table": "counter", "data": "zz"}]}`,
+		`{"rows": [{"code": "alice", "table": "counter", "data": 1}]}`,
+	}
+	for _, s := range invalid {
+		if _, err := ParseFixture([]byte(s)); err == nil {
+			t.Errorf("fixture should be invalid: %s", s)
+		}
+	}
+}
+
+func TestPackFixtureRows(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "test.abi"), []byte(testABI), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fixture, err := ParseFixture([]byte(`
+contracts:
+  - account: alice
+    wasm: test.wasm
+    abi: test.abi
+rows:
+  - code: alice
+    table: rows
+    primary_key: 1
+    data:
+      id: 1
+      owner: eosio
+      balance: "1.0000 EOS"
+      tags: [a]
+      memo: null
+      value: [int8, -1]
+  - code: bob
+    table: counter
+    data: "0100"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixture.dir = dir
+
+	codes, rows, err := fixture.packRows()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != 2 || hex.EncodeToString(rows["alice"][0].packed) != "01000000000000000000000000ea3055102700000000000004454f5300000000010161"+"0000ff" {
+		t.Fatalf("packed rows %v %x", codes, rows["alice"][0].packed)
+	}
+	if hex.EncodeToString(rows["bob"][0].packed) != "0100" {
+		t.Fatalf("packed row %x", rows["bob"][0].packed)
+	}
+
+	fixture.Rows[1].Data = map[string]interface{}{"count": 1}
+	if _, _, err := fixture.packRows(); err == nil || !strings.Contains(err.Error(), "no abi") {
+		t.Fatalf("packed a row without abi: %v", err)
+	}
+}
+
+func TestS2N(t *testing.T) {
+	for _, name := range []string{"eosio", "eosio.token", "hello", "a", "zzzzzzzzzzzzj", "1.2.3.4.5"} {
+		if !IsValidName(name) {
+			t.Errorf("%s should be valid", name)
+		}
+		if N2S(S2N(name)) != name {
+			t.Errorf("bad round trip: %s", name)
+		}
+	}
+
+	if S2N("eosio") != 0x5530ea0000000000 {
+		t.Errorf("bad value of eosio: %x", S2N("eosio"))
+	}
+
+	for _, name := range []string{"Hello", "hello.", "abcdefghijklmn", "zzzzzzzzzzzzz", "a6"} {
+		if IsValidName(name) {
+			t.Errorf("%s should be invalid", name)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package chaintester
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/uuosio/chaintester/interfaces"
+)
+
+// ChainContracts are the contracts deployed on a new chain
+type ChainContracts int
+
+const (
+	// SystemContracts deploys eosio.token and eosio.system, like NewChain_ with initialize
+	SystemContracts ChainContracts = iota
+	// TokenContract deploys only eosio.token
+	TokenContract
+	// BareChain deploys no contracts, only eosio exists
+	BareChain
+)
+
+// DefaultGenesisKey is the public key of eosio on chains created by the server
+const DefaultGenesisKey = "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"
+
+const defaultCoreSymbol = "4,EOS"
+
+// systemAccounts are the accounts used by eosio.system
+var systemAccounts = []string{
+	"eosio.bpay",
+	"eosio.msig",
+	"eosio.names",
+	"eosio.ram",
+	"eosio.ramfee",
+	"eosio.rex",
+	"eosio.saving",
+	"eosio.stake",
+	"eosio.vpay",
+}
+
+// ChainOptions are the settings of a chain created by NewChainTester. The server creates chains from
+// its own genesis state, the options are applied to the new chain before it is returned.
+type ChainOptions struct {
+	Contracts ChainContracts
+	// ContractsDir contains eosio.token/eosio.token.{wasm,abi} and eosio.system/eosio.system.{wasm,abi},
+	// the contracts are deployed from it for TokenContract and for a core symbol other than 4,EOS
+	ContractsDir string
+	// CoreSymbol is the symbol of the core token like 4,EOS, the whole max supply is issued to eosio
+	CoreSymbol string
+	// GenesisKey is the private key which replaces the owner and active keys of eosio, it is imported
+	// into the wallet of the chain and the key store so that eosio can still sign transactions
+	GenesisKey string
+	// ChainID is the expected id of the chain, which is derived by the server from its genesis state
+	// and can not be changed, creating the chain fails if it does not match
+	ChainID string
+	// InitialTime is the time of the block produced before any contract is deployed, it must be a
+	// multiple of BlockInterval. The system contracts are deployed from ContractsDir if it is set.
+	InitialTime time.Time
+	// Features are the hex digests of protocol features, which are preactivated with the preactivate_feature
+	// intrinsic in a native apply of eosio and activated in the next block, with or without contracts
+	Features []string
+}
+
+type symbol struct {
+	precision int
+	name      string
+}
+
+func parseSymbol(s string) (symbol, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 {
+		return symbol{}, newErrorf("invalid symbol: %s", s)
+	}
+	precision, err := strconv.Atoi(parts[0])
+	if err != nil || precision < 0 || precision > 18 {
+		return symbol{}, newErrorf("invalid symbol precision: %s", s)
+	}
+	name := parts[1]
+	if len(name) == 0 || len(name) > 7 {
+		return symbol{}, newErrorf("invalid symbol name: %s", s)
+	}
+	for _, c := range name {
+		if c < 'A' || c > 'Z' {
+			return symbol{}, newErrorf("invalid symbol name: %s", s)
+		}
+	}
+	return symbol{precision, name}, nil
+}
+
+func (s symbol) String() string {
+	return fmt.Sprintf("%d,%s", s.precision, s.name)
+}
+
+// format formats amount in units of the precision of s as an asset
+func (s symbol) format(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	if s.precision == 0 {
+		return fmt.Sprintf("%s%d %s", sign, amount, s.name)
+	}
+	unit := int64(1)
+	for i := 0; i < s.precision; i++ {
+		unit *= 10
+	}
+	return fmt.Sprintf("%s%d.%0*d %s", sign, amount/unit, s.precision, amount%unit, s.name)
+}
+
+// pack serializes the symbol as its precision followed by the zero padded name
+func (s symbol) pack() []byte {
+	data := make([]byte, 8)
+	data[0] = byte(s.precision)
+	copy(data[1:], s.name)
+	return data
+}
+
+// maxSupply returns the max supply of the core token, 10 billion tokens limited by the range of an asset
+func (s symbol) maxSupply() int64 {
+	supply := int64(10000000000)
+	for i := 0; i < s.precision; i++ {
+		if supply > (1<<62-1)/10 {
+			break
+		}
+		supply *= 10
+	}
+	return supply
+}
+
+func (o *ChainOptions) validate() (symbol, error) {
+	if o.CoreSymbol == "" {
+		o.CoreSymbol = defaultCoreSymbol
+	}
+	sym, err := parseSymbol(o.CoreSymbol)
+	if err != nil {
+		return sym, err
+	}
+
+	if o.Contracts < SystemContracts || o.Contracts > BareChain {
+		return sym, newErrorf("invalid chain contracts: %d", o.Contracts)
+	}
+	if o.deployContracts() && o.ContractsDir == "" {
+		if !o.InitialTime.IsZero() {
+			return sym, newErrorf("contracts dir is required to deploy the contracts after initial time %v", o.InitialTime)
+		}
+		return sym, newErrorf("contracts dir is required to deploy the contracts with core symbol %s", sym)
+	}
+	if o.GenesisKey != "" {
+		if _, err := NewSigner(o.GenesisKey); err != nil {
+			return sym, newErrorf("invalid genesis key: %v", err)
+		}
+	}
+	if id, err := hex.DecodeString(o.ChainID); o.ChainID != "" && (err != nil || len(id) != 32) {
+		return sym, newErrorf("invalid chain id: %s", o.ChainID)
+	}
+	if !o.InitialTime.IsZero() && o.InitialTime.UnixNano()%int64(BlockInterval) != 0 {
+		return sym, newErrorf("initial time %v is not a multiple of %v", o.InitialTime, BlockInterval)
+	}
+	for _, feature := range o.Features {
+		if digest, err := hex.DecodeString(feature); err != nil || len(digest) != 32 {
+			return sym, newErrorf("invalid feature digest: %s", feature)
+		}
+	}
+	return sym, nil
+}
+
+// deployContracts returns true if the contracts are deployed from ContractsDir instead of by the server,
+// the server deploys the system contracts with the default core symbol when the chain is created
+func (o *ChainOptions) deployContracts() bool {
+	if o.Contracts == TokenContract {
+		return true
+	}
+	return o.Contracts == SystemContracts && (o.CoreSymbol != defaultCoreSymbol || !o.InitialTime.IsZero())
+}
+
+func (o *ChainOptions) contract(name string) (string, string) {
+	return filepath.Join(o.ContractsDir, name, name+".wasm"), filepath.Join(o.ContractsDir, name, name+".abi")
+}
+
+// bootstrap applies the options to a new chain
+func (o *ChainOptions) bootstrap(p *ChainTester) error {
+	if o.ChainID != "" {
+		info, err := p.GetChainInfo()
+		if err != nil {
+			return err
+		}
+		if info.ChainID != o.ChainID {
+			return newErrorf("chain id %s, expected %s", info.ChainID, o.ChainID)
+		}
+	}
+
+	if !o.InitialTime.IsZero() {
+		if err := p.SetBlockTime(o.InitialTime); err != nil {
+			return newErrorf("set initial time: %v", err)
+		}
+	}
+
+	if o.deployContracts() {
+		if err := o.deploy(p); err != nil {
+			return err
+		}
+	}
+
+	if len(o.Features) != 0 {
+		if err := o.activateFeatures(p); err != nil {
+			return err
+		}
+	}
+
+	if o.GenesisKey != "" {
+		return o.setGenesisKey(p)
+	}
+	return nil
+}
+
+// activateFeatures preactivates Features and produces the block which activates them
+func (o *ChainOptions) activateFeatures(p *ChainTester) error {
+	_, err := p.pushPrivileged("preactivate", func() error {
+		for _, feature := range o.Features {
+			digest, _ := hex.DecodeString(feature)
+			if err := GetVMAPI().PreactivateFeature(defaultCtx, digest); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return newErrorf("activate features: %v", err)
+	}
+	// features are activated in the next block
+	return p.ProduceBlock()
+}
+
+// setGenesisKey replaces the owner and active keys of eosio with GenesisKey
+func (o *ChainOptions) setGenesisKey(p *ChainTester) error {
+	pubKey, err := p.KeyStore().Import(o.GenesisKey)
+	if err != nil {
+		return err
+	}
+	if err := p.ImportKey(pubKey, o.GenesisKey); err != nil {
+		return err
+	}
+
+	if _, err := p.UpdateAuth("eosio", "active", "owner", NewAuthority(1).AddKey(pubKey, 1)); err != nil {
+		return newErrorf("update eosio@active: %v", err)
+	}
+	if _, err := p.UpdateAuth("eosio", "owner", "", NewAuthority(1).AddKey(pubKey, 1)); err != nil {
+		return newErrorf("update eosio@owner: %v", err)
+	}
+	return nil
+}
+
+// deploy deploys the contracts of a bare chain from ContractsDir
+func (o *ChainOptions) deploy(p *ChainTester) error {
+	accounts := []string{"eosio.token"}
+	if o.Contracts == SystemContracts {
+		accounts = append(accounts, systemAccounts...)
+	}
+	for _, account := range accounts {
+		if _, err := p.CreateAccount("eosio", account, DefaultGenesisKey, DefaultGenesisKey, 0); err != nil {
+			return newErrorf("create account %s: %v", account, err)
+		}
+	}
+
+	wasm, abi := o.contract("eosio.token")
+	if err := p.DeployContract("eosio.token", wasm, abi); err != nil {
+		return newErrorf("deploy eosio.token: %v", err)
+	}
+
+	supply := p.coreSymbol.format(p.coreSymbol.maxSupply())
+	create, err := newAction("eosio.token", "create", map[string]string{"issuer": "eosio", "maximum_supply": supply}, PermissionLevel{"eosio.token", "active"})
+	if err != nil {
+		return err
+	}
+	issue, err := newAction("eosio.token", "issue", map[string]string{"to": "eosio", "quantity": supply, "memo": ""}, PermissionLevel{"eosio", "active"})
+	if err != nil {
+		return err
+	}
+	if _, err := p.PushActions([]*interfaces.Action{create, issue}); err != nil {
+		return newErrorf("issue %s: %v", supply, err)
+	}
+
+	if o.Contracts != SystemContracts {
+		return nil
+	}
+	wasm, abi = o.contract("eosio.system")
+	if err := p.DeployContract("eosio", wasm, abi); err != nil {
+		return newErrorf("deploy eosio.system: %v", err)
+	}
+	_, err = p.pushAuthAction(newAction("eosio", "init", map[string]interface{}{"version": 0, "core": o.CoreSymbol}, PermissionLevel{"eosio", "active"}))
+	if err != nil {
+		return newErrorf("init eosio.system: %v", err)
+	}
+	return nil
+}
+
+// readCoreSymbol reads the core symbol from the RAM market of eosio.system,
+// chains without the system contract have the default core symbol
+func (p *ChainTester) readCoreSymbol() symbol {
+	sym, _ := parseSymbol(defaultCoreSymbol)
+	ret, err := p.GetTableRows(true, "eosio", "eosio", "rammarket", "", "", 1)
+	if err != nil {
+		return sym
+	}
+	balance, err := ret.GetString("rows", 0, "quote", "balance")
+	if err != nil {
+		return sym
+	}
+	parts := strings.Split(balance, " ")
+	if len(parts) != 2 {
+		return sym
+	}
+	precision := 0
+	if i := strings.Index(parts[0], "."); i >= 0 {
+		precision = len(parts[0]) - i - 1
+	}
+	if core, err := parseSymbol(fmt.Sprintf("%d,%s", precision, parts[1])); err == nil {
+		return core
+	}
+	return sym
+}
+
+// CoreSymbol returns the symbol of the core token of the chain like 4,EOS
+func (p *ChainTester) CoreSymbol() string {
+	return p.coreSymbol.String()
+}
@@ -0,0 +1,89 @@
+package chaintester
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSymbol(t *testing.T) {
+	sym, err := parseSymbol("4,EOS")
+	if err != nil || sym != (symbol{4, "EOS"}) || sym.String() != "4,EOS" {
+		t.Fatalf("symbol %v, %v", sym, err)
+	}
+	for _, s := range []string{"EOS", "4,", "19,EOS", "x,EOS", "4,eos", "4,TOOLONGX"} {
+		if _, err := parseSymbol(s); err == nil {
+			t.Errorf("symbol %s is valid", s)
+		}
+	}
+
+	for _, test := range []struct {
+		symbol symbol
+		amount int64
+		asset  string
+	}{
+		{symbol{4, "EOS"}, 0, "0.0000 EOS"},
+		{symbol{4, "EOS"}, 100000, "10.0000 EOS"},
+		{symbol{4, "EOS"}, -12345, "-1.2345 EOS"},
+		{symbol{0, "NFT"}, 12, "12 NFT"},
+		{symbol{8, "BTC"}, 1, "0.00000001 BTC"},
+	} {
+		if asset := test.symbol.format(test.amount); asset != test.asset {
+			t.Errorf("%d formatted as %s, expected %s", test.amount, asset, test.asset)
+		}
+	}
+
+	if supply := (symbol{4, "SYS"}).format((symbol{4, "SYS"}).maxSupply()); supply != "10000000000.0000 SYS" {
+		t.Errorf("max supply %s", supply)
+	}
+	if supply := (symbol{18, "WEI"}).maxSupply(); supply <= 0 || supply > 1<<62-1 {
+		t.Errorf("max supply %d", supply)
+	}
+}
+
+func TestChainOptions(t *testing.T) {
+	options := ChainOptions{}
+	if sym, err := options.validate(); err != nil || sym != (symbol{4, "EOS"}) || options.deployContracts() {
+		t.Errorf("default options: %v, %v", sym, err)
+	}
+
+	for _, test := range []struct {
+		options ChainOptions
+		err     string
+	}{
+		{ChainOptions{Contracts: BareChain}, ""},
+		{ChainOptions{Contracts: BareChain, CoreSymbol: "4,SYS"}, ""},
+		{ChainOptions{Contracts: SystemContracts, Features: []string{"0ec7e080177b2c02b278d5088611686b49d739925a92d9bfcacd7fc6b74053bd"}}, ""},
+		{ChainOptions{Contracts: TokenContract, ContractsDir: "contracts"}, ""},
+		{ChainOptions{Contracts: TokenContract}, "contracts dir is required"},
+		{ChainOptions{CoreSymbol: "4,SYS"}, "contracts dir is required"},
+		{ChainOptions{CoreSymbol: "SYS"}, "invalid symbol"},
+		{ChainOptions{Contracts: BareChain, Features: []string{"0ec7e080177b2c02b278d5088611686b49d739925a92d9bfcacd7fc6b74053bd"}}, ""},
+		{ChainOptions{Contracts: BareChain, Features: []string{"0ec7e080"}}, "invalid feature digest"},
+		{ChainOptions{Contracts: 3}, "invalid chain contracts"},
+		{ChainOptions{GenesisKey: testPrivateKey}, ""},
+		{ChainOptions{GenesisKey: testPublicKey}, "invalid genesis key"},
+		{ChainOptions{ChainID: "8a34ec7df1b8cd06ff4a8abbaa7cc50300823350cadc59ab296cb00d104d2b8f"}, ""},
+		{ChainOptions{ChainID: "8a34ec7d"}, "invalid chain id"},
+		{ChainOptions{Contracts: BareChain, InitialTime: time.Date(2030, 1, 1, 0, 0, 0, 500000000, time.UTC)}, ""},
+		{ChainOptions{Contracts: BareChain, InitialTime: time.Date(2030, 1, 1, 0, 0, 0, 100000000, time.UTC)}, "not a multiple"},
+		{ChainOptions{InitialTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}, "contracts dir is required"},
+	} {
+		_, err := test.options.validate()
+		if test.err == "" && err != nil || test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+			t.Errorf("options %+v: %v, expected %q", test.options, err, test.err)
+		}
+	}
+
+	options = ChainOptions{Contracts: SystemContracts, ContractsDir: "contracts", CoreSymbol: "4,SYS"}
+	if _, err := options.validate(); err != nil || !options.deployContracts() {
+		t.Errorf("custom core symbol: %v", err)
+	}
+	options = ChainOptions{Contracts: SystemContracts, ContractsDir: "contracts", InitialTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+	if _, err := options.validate(); err != nil || !options.deployContracts() {
+		t.Errorf("initial time: %v", err)
+	}
+	if wasm, abi := options.contract("eosio.system"); wasm != "contracts/eosio.system/eosio.system.wasm" || abi != "contracts/eosio.system/eosio.system.abi" {
+		t.Errorf("contract files %s %s", wasm, abi)
+	}
+}
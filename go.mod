@@ -5,3 +5,12 @@ go 1.17
 require github.com/apache/thrift v0.16.0
 
 require github.com/go-errors/errors v1.4.2
+
+require (
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.0.1
+	golang.org/x/crypto v0.0.0-20211117183948-ae814b36b871
+	golang.org/x/term v0.10.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require golang.org/x/sys v0.10.0 // indirect
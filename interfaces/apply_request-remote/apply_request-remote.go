@@ -1,236 +0,0 @@
-// Code generated by Thrift Compiler (0.15.0). DO NOT EDIT.
-
-package main
-
-import (
-	"context"
-	"flag"
-	"fmt"
-	"math"
-	"net"
-	"net/url"
-	"os"
-	"strconv"
-	"strings"
-	thrift "github.com/apache/thrift/lib/go/thrift"
-	"interfaces"
-)
-
-var _ = interfaces.GoUnusedProtection__
-
-func Usage() {
-  fmt.Fprintln(os.Stderr, "Usage of ", os.Args[0], " [-h host:port] [-u url] [-f[ramed]] function [arg1 [arg2...]]:")
-  flag.PrintDefaults()
-  fmt.Fprintln(os.Stderr, "\nFunctions:")
-  fmt.Fprintln(os.Stderr, "  i32 apply_request(Uint64 receiver, Uint64 firstReceiver, Uint64 action, i32 chainTesterId)")
-  fmt.Fprintln(os.Stderr, "  i32 apply_end(i32 chainTesterId)")
-  fmt.Fprintln(os.Stderr)
-  os.Exit(0)
-}
-
-type httpHeaders map[string]string
-
-func (h httpHeaders) String() string {
-  var m map[string]string = h
-  return fmt.Sprintf("%s", m)
-}
-
-func (h httpHeaders) Set(value string) error {
-  parts := strings.Split(value, ": ")
-  if len(parts) != 2 {
-    return fmt.Errorf("header should be of format 'Key: Value'")
-  }
-  h[parts[0]] = parts[1]
-  return nil
-}
-
-func main() {
-  flag.Usage = Usage
-  var host string
-  var port int
-  var protocol string
-  var urlString string
-  var framed bool
-  var useHttp bool
-  headers := make(httpHeaders)
-  var parsedUrl *url.URL
-  var trans thrift.TTransport
-  _ = strconv.Atoi
-  _ = math.Abs
-  flag.Usage = Usage
-  flag.StringVar(&host, "h", "localhost", "Specify host and port")
-  flag.IntVar(&port, "p", 9090, "Specify port")
-  flag.StringVar(&protocol, "P", "binary", "Specify the protocol (binary, compact, simplejson, json)")
-  flag.StringVar(&urlString, "u", "", "Specify the url")
-  flag.BoolVar(&framed, "framed", false, "Use framed transport")
-  flag.BoolVar(&useHttp, "http", false, "Use http")
-  flag.Var(headers, "H", "Headers to set on the http(s) request (e.g. -H \"Key: Value\")")
-  flag.Parse()
-  
-  if len(urlString) > 0 {
-    var err error
-    parsedUrl, err = url.Parse(urlString)
-    if err != nil {
-      fmt.Fprintln(os.Stderr, "Error parsing URL: ", err)
-      flag.Usage()
-    }
-    host = parsedUrl.Host
-    useHttp = len(parsedUrl.Scheme) <= 0 || parsedUrl.Scheme == "http" || parsedUrl.Scheme == "https"
-  } else if useHttp {
-    _, err := url.Parse(fmt.Sprint("http://", host, ":", port))
-    if err != nil {
-      fmt.Fprintln(os.Stderr, "Error parsing URL: ", err)
-      flag.Usage()
-    }
-  }
-  
-  cmd := flag.Arg(0)
-  var err error
-  var cfg *thrift.TConfiguration = nil
-  if useHttp {
-    trans, err = thrift.NewTHttpClient(parsedUrl.String())
-    if len(headers) > 0 {
-      httptrans := trans.(*thrift.THttpClient)
-      for key, value := range headers {
-        httptrans.SetHeader(key, value)
-      }
-    }
-  } else {
-    portStr := fmt.Sprint(port)
-    if strings.Contains(host, ":") {
-           host, portStr, err = net.SplitHostPort(host)
-           if err != nil {
-                   fmt.Fprintln(os.Stderr, "error with host:", err)
-                   os.Exit(1)
-           }
-    }
-    trans = thrift.NewTSocketConf(net.JoinHostPort(host, portStr), cfg)
-    if err != nil {
-      fmt.Fprintln(os.Stderr, "error resolving address:", err)
-      os.Exit(1)
-    }
-    if framed {
-      trans = thrift.NewTFramedTransportConf(trans, cfg)
-    }
-  }
-  if err != nil {
-    fmt.Fprintln(os.Stderr, "Error creating transport", err)
-    os.Exit(1)
-  }
-  defer trans.Close()
-  var protocolFactory thrift.TProtocolFactory
-  switch protocol {
-  case "compact":
-    protocolFactory = thrift.NewTCompactProtocolFactoryConf(cfg)
-    break
-  case "simplejson":
-    protocolFactory = thrift.NewTSimpleJSONProtocolFactoryConf(cfg)
-    break
-  case "json":
-    protocolFactory = thrift.NewTJSONProtocolFactory()
-    break
-  case "binary", "":
-    protocolFactory = thrift.NewTBinaryProtocolFactoryConf(cfg)
-    break
-  default:
-    fmt.Fprintln(os.Stderr, "Invalid protocol specified: ", protocol)
-    Usage()
-    os.Exit(1)
-  }
-  iprot := protocolFactory.GetProtocol(trans)
-  oprot := protocolFactory.GetProtocol(trans)
-  client := interfaces.NewApplyRequestClient(thrift.NewTStandardClient(iprot, oprot))
-  if err := trans.Open(); err != nil {
-    fmt.Fprintln(os.Stderr, "Error opening socket to ", host, ":", port, " ", err)
-    os.Exit(1)
-  }
-  
-  switch cmd {
-  case "apply_request":
-    if flag.NArg() - 1 != 4 {
-      fmt.Fprintln(os.Stderr, "ApplyRequest requires 4 args")
-      flag.Usage()
-    }
-    arg165 := flag.Arg(1)
-    mbTrans166 := thrift.NewTMemoryBufferLen(len(arg165))
-    defer mbTrans166.Close()
-    _, err167 := mbTrans166.WriteString(arg165)
-    if err167 != nil {
-      Usage()
-      return
-    }
-    factory168 := thrift.NewTJSONProtocolFactory()
-    jsProt169 := factory168.GetProtocol(mbTrans166)
-    argvalue0 := interfaces.NewUint64()
-    err170 := argvalue0.Read(context.Background(), jsProt169)
-    if err170 != nil {
-      Usage()
-      return
-    }
-    value0 := argvalue0
-    arg171 := flag.Arg(2)
-    mbTrans172 := thrift.NewTMemoryBufferLen(len(arg171))
-    defer mbTrans172.Close()
-    _, err173 := mbTrans172.WriteString(arg171)
-    if err173 != nil {
-      Usage()
-      return
-    }
-    factory174 := thrift.NewTJSONProtocolFactory()
-    jsProt175 := factory174.GetProtocol(mbTrans172)
-    argvalue1 := interfaces.NewUint64()
-    err176 := argvalue1.Read(context.Background(), jsProt175)
-    if err176 != nil {
-      Usage()
-      return
-    }
-    value1 := argvalue1
-    arg177 := flag.Arg(3)
-    mbTrans178 := thrift.NewTMemoryBufferLen(len(arg177))
-    defer mbTrans178.Close()
-    _, err179 := mbTrans178.WriteString(arg177)
-    if err179 != nil {
-      Usage()
-      return
-    }
-    factory180 := thrift.NewTJSONProtocolFactory()
-    jsProt181 := factory180.GetProtocol(mbTrans178)
-    argvalue2 := interfaces.NewUint64()
-    err182 := argvalue2.Read(context.Background(), jsProt181)
-    if err182 != nil {
-      Usage()
-      return
-    }
-    value2 := argvalue2
-    tmp3, err183 := (strconv.Atoi(flag.Arg(4)))
-    if err183 != nil {
-      Usage()
-      return
-    }
-    argvalue3 := int32(tmp3)
-    value3 := argvalue3
-    fmt.Print(client.ApplyRequest(context.Background(), value0, value1, value2, value3))
-    fmt.Print("\n")
-    break
-  case "apply_end":
-    if flag.NArg() - 1 != 1 {
-      fmt.Fprintln(os.Stderr, "ApplyEnd requires 1 args")
-      flag.Usage()
-    }
-    tmp0, err184 := (strconv.Atoi(flag.Arg(1)))
-    if err184 != nil {
-      Usage()
-      return
-    }
-    argvalue0 := int32(tmp0)
-    value0 := argvalue0
-    fmt.Print(client.ApplyEnd(context.Background(), value0))
-    fmt.Print("\n")
-    break
-  case "":
-    Usage()
-    break
-  default:
-    fmt.Fprintln(os.Stderr, "Invalid function ", cmd)
-  }
-}
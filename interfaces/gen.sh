@@ -0,0 +1,20 @@
+#!/bin/sh
+# Regenerates package interfaces from interfaces.thrift, run by `go generate ./interfaces`.
+# The Thrift compiler must be of the same version as github.com/apache/thrift in go.mod,
+# so that the generated code matches the library it is built with.
+#
+# The code is generated into the parent of the directory given as the first argument,
+# .. by default, TestGenerated generates it into a temporary directory.
+set -e
+
+required=$(go list -m -f '{{.Version}}' github.com/apache/thrift | sed 's/^v//')
+found=$(thrift --version 2>/dev/null | awk '{print $3}')
+if [ "$found" != "$required" ]; then
+    echo "thrift $required is required, found ${found:-none}" >&2
+    exit 1
+fi
+
+out=${1:-..}
+thrift -r --gen go:package_prefix=github.com/uuosio/chaintester/,thrift_import=github.com/apache/thrift/lib/go/thrift -out "$out" interfaces.thrift
+# the generated *-remote command line tools are replaced by cmd/chaintester
+rm -rf "$out"/interfaces/*-remote
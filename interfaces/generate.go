@@ -0,0 +1,5 @@
+package interfaces
+
+// The code in this package except this file is generated from interfaces.thrift by gen.sh,
+// TestGenerated checks that it is up to date and TestIDL that it matches the IDL.
+//go:generate sh gen.sh
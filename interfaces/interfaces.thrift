@@ -0,0 +1,254 @@
+// Interfaces between ChainTester and the chain tester server.
+//
+// IPCChainTester and PushActions are served by the chain tester server. ApplyRequest is
+// served by the tester to run actions of contracts which are debugged in Go, and Apply is
+// the VM API served by the server to those contracts while an action runs.
+//
+// The Go code in this directory is generated from this file, run `go generate ./interfaces`
+// after changing it.
+
+namespace go interfaces
+
+exception TransactionException {
+    1: string exc,
+}
+
+exception AssertException {
+    1: string error_message,
+}
+
+union ActionArguments {
+    1: binary raw_args,
+    2: string json_args,
+}
+
+struct Action {
+    1: string account,
+    2: string action,
+    3: string permissions,
+    4: ActionArguments arguments,
+}
+
+struct Uint64 {
+    1: binary rawValue,
+}
+
+struct DataBuffer {
+    1: i32 size,
+    2: binary buffer,
+}
+
+struct NextPreviousReturn {
+    1: i32 iterator,
+    2: Uint64 primary,
+}
+
+struct IteratorPrimaryReturn {
+    1: i32 iterator,
+    2: Uint64 primary,
+}
+
+struct FindPrimaryReturn {
+    1: i32 iterator,
+    2: binary secondary,
+}
+
+struct FindSecondaryReturn {
+    1: i32 iterator,
+    2: Uint64 primary,
+}
+
+struct LowerBoundUpperBoundReturn {
+    1: i32 iterator,
+    2: binary secondary,
+    3: Uint64 primary,
+}
+
+struct GetResourceLimitsReturn {
+    1: i64 ram_bytes,
+    2: i64 net_weight,
+    3: i64 cpu_weight,
+}
+
+service IPCChainTester {
+    oneway void init_vm_api(),
+    oneway void init_apply_request(),
+    bool set_native_contract(1: i32 id, 2: string contract, 3: string dylib),
+    void enable_debugging(1: bool enable),
+    void enable_debug_contract(1: i32 id, 2: string contract, 3: bool enable),
+    bool is_debug_contract_enabled(1: i32 id, 2: string contract),
+    binary pack_abi(1: string abi),
+    binary pack_action_args(1: i32 id, 2: string contract, 3: string action, 4: string action_args),
+    binary unpack_action_args(1: i32 id, 2: string contract, 3: string action, 4: binary raw_args),
+    i32 new_chain(1: bool initialize),
+    i32 free_chain(1: i32 id),
+    string get_info(1: i32 id),
+    string create_key(1: string key_type),
+    string get_account(1: i32 id, 2: string account),
+    string create_account(1: i32 id, 2: string creator, 3: string account, 4: string owner_key, 5: string active_key, 6: i64 ram_bytes, 7: i64 stake_net, 8: i64 stake_cpu),
+    bool import_key(1: i32 id, 2: string pub_key, 3: string priv_key),
+    string get_required_keys(1: i32 id, 2: string transaction, 3: list<string> available_keys),
+    void produce_block(1: i32 id, 2: i64 next_block_skip_seconds),
+    binary push_action(1: i32 id, 2: string account, 3: string action, 4: ActionArguments arguments, 5: string permissions),
+    binary push_actions(1: i32 id, 2: list<Action> actions),
+    binary deploy_contract(1: i32 id, 2: string account, 3: string wasm, 4: string abi),
+    string get_table_rows(1: i32 id, 2: bool json, 3: string code, 4: string scope, 5: string table, 6: string lower_bound, 7: string upper_bound, 8: i64 limit, 9: string key_type, 10: string index_position, 11: string encode_type, 12: bool reverse, 13: bool show_payer),
+    void set_block_time(1: i32 id, 2: i64 block_time),
+    i32 snapshot(1: i32 id),
+    void restore(1: i32 id, 2: i32 snapshot_id),
+    void free_snapshot(1: i32 id, 2: i32 snapshot_id),
+    i32 fork(1: i32 id),
+    i32 begin_undo_session(1: i32 id),
+    void rollback_undo_session(1: i32 id, 2: i32 session_id),
+    void commit_undo_session(1: i32 id, 2: i32 session_id),
+    binary push_transaction(1: i32 id, 2: string transaction),
+    string get_scheduled_transactions(1: i32 id),
+    binary execute_deferred(1: i32 id, 2: string trx_id),
+    binary push_signed_transaction(1: i32 id, 2: string signed_transaction),
+    void enable_auto_sign(1: i32 id, 2: bool enable),
+}
+
+service PushActions {
+    i32 push_actions(1: list<Action> actions),
+}
+
+service ApplyRequest {
+    i32 apply_request(1: Uint64 receiver, 2: Uint64 firstReceiver, 3: Uint64 action, 4: i32 chainTesterId),
+    i32 apply_end(1: i32 chainTesterId),
+}
+
+service Apply {
+    i32 end_apply(),
+    binary get_active_producers(),
+    GetResourceLimitsReturn get_resource_limits(1: Uint64 account),
+    void set_resource_limits(1: Uint64 account, 2: i64 ram_bytes, 3: i64 net_weight, 4: i64 cpu_weight),
+    i64 set_proposed_producers(1: binary producer_data),
+    i64 set_proposed_producers_ex(1: Uint64 producer_data_format, 2: binary producer_data),
+    bool is_privileged(1: Uint64 account),
+    void set_privileged(1: Uint64 account, 2: bool is_priv),
+    void set_blockchain_parameters_packed(1: binary data),
+    binary get_blockchain_parameters_packed(),
+    void preactivate_feature(1: binary feature_digest),
+    i32 check_transaction_authorization(1: binary trx_data, 2: binary pubkeys_data, 3: binary perms_data),
+    i32 check_permission_authorization(1: Uint64 account, 2: Uint64 permission, 3: binary pubkeys_data, 4: binary perms_data, 5: Uint64 delay_us),
+    i64 get_permission_last_used(1: Uint64 account, 2: Uint64 permission),
+    i64 get_account_creation_time(1: Uint64 account),
+    void prints(1: string cstr),
+    void prints_l(1: binary cstr),
+    void printi(1: i64 n),
+    void printui(1: Uint64 n),
+    void printi128(1: binary value),
+    void printui128(1: binary value),
+    void printsf(1: binary value),
+    void printdf(1: binary value),
+    void printqf(1: binary value),
+    void printn(1: Uint64 name),
+    void printhex(1: binary data),
+    i32 action_data_size(),
+    binary read_action_data(),
+    void require_recipient(1: Uint64 name),
+    void require_auth(1: Uint64 name),
+    bool has_auth(1: Uint64 name),
+    void require_auth2(1: Uint64 name, 2: Uint64 permission),
+    bool is_account(1: Uint64 name),
+    void send_inline(1: binary serialized_action),
+    void send_context_free_inline(1: binary serialized_data),
+    Uint64 publication_time(),
+    Uint64 current_receiver(),
+    void eosio_assert(1: bool test, 2: binary msg),
+    void eosio_assert_message(1: bool test, 2: binary msg),
+    void eosio_assert_code(1: bool test, 2: Uint64 code),
+    void eosio_exit(1: i32 code),
+    Uint64 current_time(),
+    bool is_feature_activated(1: binary feature_digest),
+    Uint64 get_sender(),
+    void assert_sha256(1: binary data, 2: binary hash),
+    void assert_sha1(1: binary data, 2: binary hash),
+    void assert_sha512(1: binary data, 2: binary hash),
+    void assert_ripemd160(1: binary data, 2: binary hash),
+    binary sha256(1: binary data),
+    binary sha1(1: binary data),
+    binary sha512(1: binary data),
+    binary ripemd160(1: binary data),
+    binary recover_key(1: binary digest, 2: binary sig),
+    void assert_recover_key(1: binary digest, 2: binary sig, 3: binary pub),
+    void send_deferred(1: binary sender_id, 2: Uint64 payer, 3: binary serialized_transaction, 4: i32 replace_existing),
+    i32 cancel_deferred(1: binary sender_id),
+    binary read_transaction(),
+    i32 transaction_size(),
+    i32 tapos_block_num(),
+    i32 tapos_block_prefix(),
+    i64 expiration(),
+    binary get_action(1: i32 _type, 2: i32 index),
+    binary get_context_free_data(1: i32 index),
+    i32 db_store_i64(1: Uint64 scope, 2: Uint64 table, 3: Uint64 payer, 4: Uint64 id, 5: binary data),
+    void db_update_i64(1: i32 iterator, 2: Uint64 payer, 3: binary data),
+    void db_remove_i64(1: i32 iterator),
+    binary db_get_i64(1: i32 iterator),
+    NextPreviousReturn db_next_i64(1: i32 iterator),
+    NextPreviousReturn db_previous_i64(1: i32 iterator),
+    i32 db_find_i64(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: Uint64 id),
+    i32 db_lowerbound_i64(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: Uint64 id),
+    i32 db_upperbound_i64(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: Uint64 id),
+    i32 db_end_i64(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table),
+    i32 db_idx64_store(1: Uint64 scope, 2: Uint64 table, 3: Uint64 payer, 4: Uint64 id, 5: Uint64 secondary),
+    void db_idx64_update(1: i32 iterator, 2: Uint64 payer, 3: Uint64 secondary),
+    void db_idx64_remove(1: i32 iterator),
+    NextPreviousReturn db_idx64_next(1: i32 iterator),
+    NextPreviousReturn db_idx64_previous(1: i32 iteratory),
+    FindPrimaryReturn db_idx64_find_primary(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: Uint64 primary),
+    FindSecondaryReturn db_idx64_find_secondary(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: Uint64 secondary),
+    LowerBoundUpperBoundReturn db_idx64_lowerbound(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: Uint64 secondary, 5: Uint64 primary),
+    LowerBoundUpperBoundReturn db_idx64_upperbound(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: Uint64 secondary, 5: Uint64 primary),
+    i32 db_idx64_end(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table),
+    i32 db_idx128_store(1: Uint64 scope, 2: Uint64 table, 3: Uint64 payer, 4: Uint64 id, 5: binary secondary),
+    void db_idx128_update(1: i32 iterator, 2: Uint64 payer, 3: binary secondary),
+    void db_idx128_remove(1: i32 iterator),
+    NextPreviousReturn db_idx128_next(1: i32 iterator),
+    NextPreviousReturn db_idx128_previous(1: i32 iterator),
+    FindPrimaryReturn db_idx128_find_primary(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: Uint64 primary),
+    FindSecondaryReturn db_idx128_find_secondary(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: binary secondary),
+    LowerBoundUpperBoundReturn db_idx128_lowerbound(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: binary secondary, 5: Uint64 primary),
+    LowerBoundUpperBoundReturn db_idx128_upperbound(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: binary secondary, 5: Uint64 primary),
+    i32 db_idx128_end(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table),
+    i32 db_idx256_store(1: Uint64 scope, 2: Uint64 table, 3: Uint64 payer, 4: Uint64 id, 5: binary data),
+    void db_idx256_update(1: i32 iterator, 2: Uint64 payer, 3: binary data),
+    void db_idx256_remove(1: i32 iterator),
+    NextPreviousReturn db_idx256_next(1: i32 iterator),
+    NextPreviousReturn db_idx256_previous(1: i32 iterator),
+    FindPrimaryReturn db_idx256_find_primary(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: Uint64 primary),
+    FindSecondaryReturn db_idx256_find_secondary(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: binary data),
+    LowerBoundUpperBoundReturn db_idx256_lowerbound(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: binary data, 5: Uint64 primary),
+    LowerBoundUpperBoundReturn db_idx256_upperbound(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: binary data, 5: Uint64 primary),
+    i32 db_idx256_end(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table),
+    i32 db_idx_double_store(1: Uint64 scope, 2: Uint64 table, 3: Uint64 payer, 4: Uint64 id, 5: binary secondary),
+    void db_idx_double_update(1: i32 iterator, 2: Uint64 payer, 3: binary secondary),
+    void db_idx_double_remove(1: i32 iterator),
+    NextPreviousReturn db_idx_double_next(1: i32 iterator),
+    NextPreviousReturn db_idx_double_previous(1: i32 iterator),
+    FindPrimaryReturn db_idx_double_find_primary(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: Uint64 primary),
+    FindSecondaryReturn db_idx_double_find_secondary(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: binary secondary),
+    LowerBoundUpperBoundReturn db_idx_double_lowerbound(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: binary secondary, 5: Uint64 primary),
+    LowerBoundUpperBoundReturn db_idx_double_upperbound(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: binary secondary, 5: Uint64 primary),
+    i32 db_idx_double_end(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table),
+    i32 db_idx_long_double_store(1: Uint64 scope, 2: Uint64 table, 3: Uint64 payer, 4: Uint64 id, 5: binary secondary),
+    void db_idx_long_double_update(1: i32 iterator, 2: Uint64 payer, 3: binary secondary),
+    void db_idx_long_double_remove(1: i32 iterator),
+    NextPreviousReturn db_idx_long_double_next(1: i32 iterator),
+    NextPreviousReturn db_idx_long_double_previous(1: i32 iterator),
+    FindPrimaryReturn db_idx_long_double_find_primary(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: Uint64 primary),
+    FindSecondaryReturn db_idx_long_double_find_secondary(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: binary secondary),
+    LowerBoundUpperBoundReturn db_idx_long_double_lowerbound(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: binary secondary, 5: Uint64 primary),
+    LowerBoundUpperBoundReturn db_idx_long_double_upperbound(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table, 4: binary secondary, 5: Uint64 primary),
+    i32 db_idx_long_double_end(1: Uint64 code, 2: Uint64 scope, 3: Uint64 table),
+    void set_action_return_value(1: binary data),
+    binary get_code_hash(1: Uint64 account, 2: i64 struct_version),
+    i64 get_block_num(),
+    binary sha3(1: binary data, 2: i32 keccak),
+    binary blake2_f(1: i64 rounds, 2: binary state, 3: binary msg, 4: binary t0_offset, 5: binary t1_offset, 6: i32 final),
+    binary k1_recover(1: binary sig, 2: binary dig),
+    binary alt_bn128_add(1: binary op1, 2: binary op2),
+    binary alt_bn128_mul(1: binary g1, 2: binary scalar),
+    i32 alt_bn128_pair(1: binary pairs),
+    binary mod_exp(1: binary base, 2: binary exp, 3: binary mod),
+}
@@ -0,0 +1,283 @@
+package interfaces
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	thrift "github.com/apache/thrift/lib/go/thrift"
+)
+
+type idlField struct {
+	id   string
+	typ  string
+	name string
+}
+
+type idlMethod struct {
+	ret  string
+	name string
+	args []idlField
+}
+
+type idl struct {
+	structs  map[string][]idlField
+	services map[string][]idlMethod
+}
+
+var (
+	idlCommentRe = regexp.MustCompile(`(?m)//.*$`)
+	idlBlockRe   = regexp.MustCompile(`(?s)\b(struct|union|exception|service)\s+(\w+)\s*\{(.*?)\n\}`)
+	idlFieldRe   = regexp.MustCompile(`(\d+)\s*:\s*(?:optional\s+|required\s+)?([\w<>, ]+?)\s+(\w+)\s*$`)
+	idlMethodRe  = regexp.MustCompile(`(?s)^(?:oneway\s+)?([\w<>, ]+?)\s+(\w+)\s*\((.*)\)$`)
+)
+
+func splitIDLList(s string) []string {
+	var items []string
+	depth := 0
+	start := 0
+	for i, c := range s {
+		switch c {
+		case '<', '(':
+			depth++
+		case '>', ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				items = append(items, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	items = append(items, s[start:])
+
+	ret := items[:0]
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			ret = append(ret, item)
+		}
+	}
+	return ret
+}
+
+func parseIDLFields(t *testing.T, s string) []idlField {
+	var fields []idlField
+	for _, item := range splitIDLList(s) {
+		m := idlFieldRe.FindStringSubmatch(item)
+		if m == nil {
+			t.Fatalf("invalid field: %s", item)
+		}
+		fields = append(fields, idlField{m[1], strings.ReplaceAll(m[2], " ", ""), m[3]})
+	}
+	return fields
+}
+
+func parseIDL(t *testing.T, file string) *idl {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := idlCommentRe.ReplaceAllString(string(data), "")
+
+	ret := &idl{map[string][]idlField{}, map[string][]idlMethod{}}
+	for _, block := range idlBlockRe.FindAllStringSubmatch(src, -1) {
+		kind, name, body := block[1], block[2], block[3]
+		if kind != "service" {
+			ret.structs[name] = parseIDLFields(t, body)
+			continue
+		}
+		for _, item := range splitIDLList(body) {
+			m := idlMethodRe.FindStringSubmatch(item)
+			if m == nil {
+				t.Fatalf("invalid method: %s", item)
+			}
+			ret.services[name] = append(ret.services[name], idlMethod{strings.ReplaceAll(m[1], " ", ""), m[2], parseIDLFields(t, m[3])})
+		}
+	}
+	return ret
+}
+
+var idlTypes = map[string]reflect.Type{
+	"bool":   reflect.TypeOf(false),
+	"byte":   reflect.TypeOf(int8(0)),
+	"i16":    reflect.TypeOf(int16(0)),
+	"i32":    reflect.TypeOf(int32(0)),
+	"i64":    reflect.TypeOf(int64(0)),
+	"double": reflect.TypeOf(float64(0)),
+	"string": reflect.TypeOf(""),
+	"binary": reflect.TypeOf([]byte{}),
+
+	"TransactionException":       reflect.TypeOf(TransactionException{}),
+	"AssertException":            reflect.TypeOf(AssertException{}),
+	"ActionArguments":            reflect.TypeOf(ActionArguments{}),
+	"Action":                     reflect.TypeOf(Action{}),
+	"Uint64":                     reflect.TypeOf(Uint64{}),
+	"DataBuffer":                 reflect.TypeOf(DataBuffer{}),
+	"NextPreviousReturn":         reflect.TypeOf(NextPreviousReturn{}),
+	"IteratorPrimaryReturn":      reflect.TypeOf(IteratorPrimaryReturn{}),
+	"FindPrimaryReturn":          reflect.TypeOf(FindPrimaryReturn{}),
+	"FindSecondaryReturn":        reflect.TypeOf(FindSecondaryReturn{}),
+	"LowerBoundUpperBoundReturn": reflect.TypeOf(LowerBoundUpperBoundReturn{}),
+	"GetResourceLimitsReturn":    reflect.TypeOf(GetResourceLimitsReturn{}),
+}
+
+// goType returns the Go type generated for an IDL type, structs are referenced by pointers
+func goType(t *testing.T, typ string) reflect.Type {
+	if strings.HasPrefix(typ, "list<") {
+		return reflect.SliceOf(goType(t, strings.TrimSuffix(strings.TrimPrefix(typ, "list<"), ">")))
+	}
+	ret, ok := idlTypes[typ]
+	if !ok {
+		t.Fatalf("unknown IDL type %s, add the generated type to idlTypes", typ)
+	}
+	if ret.Kind() == reflect.Struct {
+		return reflect.PtrTo(ret)
+	}
+	return ret
+}
+
+func derefType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+	return t
+}
+
+func TestIDL(t *testing.T) {
+	idl := parseIDL(t, "interfaces.thrift")
+
+	for name, fields := range idl.structs {
+		goStruct := goType(t, name).Elem()
+		if goStruct.NumField() != len(fields) {
+			t.Errorf("%s: %d fields in IDL, %d fields generated", name, len(fields), goStruct.NumField())
+			continue
+		}
+		for i, field := range fields {
+			goField := goStruct.Field(i)
+			if tag := goField.Tag.Get("thrift"); !strings.HasPrefix(tag+",", field.name+","+field.id+",") {
+				t.Errorf("%s.%s: thrift tag %q does not match field %s: %s", name, field.name, tag, field.id, field.name)
+			}
+			// optional scalar fields are generated as pointers
+			if derefType(goField.Type) != derefType(goType(t, field.typ)) {
+				t.Errorf("%s.%s: type %s does not match %s", name, field.name, goField.Type, field.typ)
+			}
+		}
+	}
+
+	processors := map[string]thrift.TProcessor{
+		"IPCChainTester": NewIPCChainTesterProcessor(nil),
+		"PushActions":    NewPushActionsProcessor(nil),
+		"ApplyRequest":   NewApplyRequestProcessor(nil),
+		"Apply":          NewApplyProcessor(nil),
+	}
+	handlers := map[string]reflect.Type{
+		"IPCChainTester": reflect.TypeOf((*IPCChainTester)(nil)).Elem(),
+		"PushActions":    reflect.TypeOf((*PushActions)(nil)).Elem(),
+		"ApplyRequest":   reflect.TypeOf((*ApplyRequest)(nil)).Elem(),
+		"Apply":          reflect.TypeOf((*Apply)(nil)).Elem(),
+	}
+	contextType := reflect.TypeOf((*context.Context)(nil)).Elem()
+
+	if len(idl.services) != len(processors) {
+		t.Errorf("%d services in IDL, %d services generated", len(idl.services), len(processors))
+	}
+	for service, methods := range idl.services {
+		processor, ok := processors[service]
+		if !ok {
+			t.Errorf("service %s is not generated", service)
+			continue
+		}
+		processorMap := processor.ProcessorMap()
+		handler := handlers[service]
+		if len(processorMap) != len(methods) || handler.NumMethod() != len(methods) {
+			t.Errorf("%s: %d methods in IDL, %d methods generated", service, len(methods), len(processorMap))
+		}
+
+		for _, method := range methods {
+			function, ok := processorMap[method.name]
+			if !ok {
+				t.Errorf("%s.%s is not generated", service, method.name)
+				continue
+			}
+			// the processor of a method is named like iPCChainTesterProcessorPackActionArgs_
+			prefix := strings.ToLower(service[:1]) + service[1:] + "Processor"
+			goName := strings.TrimPrefix(reflect.TypeOf(function).Elem().Name(), prefix)
+			goMethod, ok := handler.MethodByName(goName)
+			if !ok {
+				t.Errorf("%s.%s: method %s not found", service, method.name, goName)
+				continue
+			}
+
+			expected := []reflect.Type{contextType}
+			for _, arg := range method.args {
+				expected = append(expected, goType(t, arg.typ))
+			}
+			var params []reflect.Type
+			for i := 0; i < goMethod.Type.NumIn(); i++ {
+				params = append(params, goMethod.Type.In(i))
+			}
+			if !reflect.DeepEqual(params, expected) {
+				t.Errorf("%s.%s: parameters %v do not match %v", service, method.name, params, expected)
+			}
+
+			numOut := goMethod.Type.NumOut()
+			if method.ret == "void" {
+				if numOut != 1 {
+					t.Errorf("%s.%s: void method returns %d values", service, method.name, numOut)
+				}
+			} else if numOut != 2 || goMethod.Type.Out(0) != goType(t, method.ret) {
+				t.Errorf("%s.%s: return type does not match %s", service, method.name, method.ret)
+			}
+		}
+	}
+}
+
+// TestGenerated regenerates the code from the IDL and checks that it is the checked in code,
+// it is skipped if the Thrift compiler required by gen.sh is not installed
+func TestGenerated(t *testing.T) {
+	if _, err := exec.LookPath("thrift"); err != nil {
+		t.Skip("thrift not found")
+	}
+
+	out := t.TempDir()
+	if output, err := exec.Command("sh", "gen.sh", out).CombinedOutput(); err != nil {
+		t.Fatalf("gen.sh: %v\n%s", err, output)
+	}
+
+	generated, err := filepath.Glob(filepath.Join(out, "interfaces", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := map[string]bool{}
+	for _, file := range generated {
+		name := filepath.Base(file)
+		names[name] = true
+		expected, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual, err := os.ReadFile(name)
+		if err != nil {
+			t.Errorf("%s is not checked in: %v", name, err)
+			continue
+		}
+		if string(actual) != string(expected) {
+			t.Errorf("%s does not match interfaces.thrift, run `go generate ./interfaces`", name)
+		}
+	}
+
+	checkedIn, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range checkedIn {
+		if !names[name] && name != "generate.go" && !strings.HasSuffix(name, "_test.go") {
+			t.Errorf("%s is not generated from interfaces.thrift", name)
+		}
+	}
+}
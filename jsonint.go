@@ -0,0 +1,47 @@
+package chaintester
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// JsonInt64 is an int64 which is decoded from either a JSON number or a JSON string,
+// the chain encodes 64 bit integers as strings when they can not be represented by a double
+type JsonInt64 int64
+
+// JsonUint64 is the unsigned version of JsonInt64
+type JsonUint64 uint64
+
+func unquoteNumber(data []byte) string {
+	return strings.Trim(string(data), `"`)
+}
+
+func (n JsonInt64) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(n), 10)), nil
+}
+
+func (n *JsonInt64) UnmarshalJSON(data []byte) error {
+	v, err := strconv.ParseInt(unquoteNumber(data), 10, 64)
+	if err != nil {
+		return newErrorf("invalid int64: %s", string(data))
+	}
+	*n = JsonInt64(v)
+	return nil
+}
+
+func (n JsonUint64) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatUint(uint64(n), 10)), nil
+}
+
+func (n *JsonUint64) UnmarshalJSON(data []byte) error {
+	v, err := strconv.ParseUint(unquoteNumber(data), 10, 64)
+	if err != nil {
+		return newErrorf("invalid uint64: %s", string(data))
+	}
+	*n = JsonUint64(v)
+	return nil
+}
+
+var _ json.Marshaler = JsonInt64(0)
+var _ json.Unmarshaler = (*JsonUint64)(nil)
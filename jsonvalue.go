@@ -1,9 +1,11 @@
 package chaintester
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -36,6 +38,8 @@ func newErrorf(format string, args ...interface{}) error {
 type JsonValue struct {
 	raw   []byte
 	value interface{}
+	// console output of the transaction if the value is the trace returned by a push
+	console []ActionConsole
 }
 
 func NewJsonValue(value []byte) *JsonValue {
@@ -63,10 +67,23 @@ func (b *JsonValue) SetValue(value interface{}) error {
 	return nil
 }
 
+// decodeScalar returns the decoded text of a JSON string, other scalars are returned as they are
+func decodeScalar(raw string) string {
+	s := strings.TrimSpace(raw)
+	if len(s) > 0 && s[0] == '"' {
+		var decoded string
+		if err := json.Unmarshal([]byte(s), &decoded); err == nil {
+			return decoded
+		}
+		return strings.Trim(s, "\"")
+	}
+	return s
+}
+
 func parseSubValue(subValue *JsonValue) interface{} {
 	switch v := subValue.value.(type) {
 	case string:
-		return strings.Trim(v, "\"")
+		return decodeScalar(v)
 	case []JsonValue, map[string]JsonValue:
 		return subValue.value
 	}
@@ -76,7 +93,7 @@ func parseSubValue(subValue *JsonValue) interface{} {
 func (b *JsonValue) GetStringValue() (string, bool) {
 	switch v := b.value.(type) {
 	case string:
-		return strings.Trim(v, "\""), true
+		return decodeScalar(v), true
 	default:
 		return "", false
 	}
@@ -180,11 +197,11 @@ func (b *JsonValue) GetTime(keys ...interface{}) (*time.Time, error) {
 func (b JsonValue) MarshalJSON() ([]byte, error) {
 	switch v := b.value.(type) {
 	case string:
-		if _, err := strconv.ParseInt(v, 10, 64); err == nil {
+		// scalars are kept as JSON text, strings set by SetValue which are not valid JSON are quoted
+		if json.Valid([]byte(v)) {
 			return []byte(v), nil
-		} else {
-			return []byte(v), nil //[]byte(strconv.Quote(v)), nil
 		}
+		return json.Marshal(v)
 	case JsonValue:
 		if s, ok := v.value.(string); ok {
 			return []byte(s), nil
@@ -199,7 +216,6 @@ func (b JsonValue) MarshalJSON() ([]byte, error) {
 }
 
 func (b *JsonValue) UnmarshalJSON(data []byte) error {
-	// fmt.Println("+++++:UnmarshaJSON", string(data))
 	if data[0] == '{' {
 		m := make(map[string]JsonValue)
 		err := json.Unmarshal(data, &m)
@@ -228,3 +244,428 @@ func (b *JsonValue) ToString() string {
 	// value, _ := json.Marshal(b.value)
 	// return string(value)
 }
+
+type JsonType int
+
+const (
+	JsonNull JsonType = iota
+	JsonBool
+	JsonNumber
+	JsonString
+	JsonArray
+	JsonObject
+)
+
+func (t JsonType) String() string {
+	switch t {
+	case JsonNull:
+		return "null"
+	case JsonBool:
+		return "bool"
+	case JsonNumber:
+		return "number"
+	case JsonString:
+		return "string"
+	case JsonArray:
+		return "array"
+	case JsonObject:
+		return "object"
+	}
+	return "unknown"
+}
+
+// Type returns the JSON type of the value
+func (b *JsonValue) Type() JsonType {
+	switch v := b.value.(type) {
+	case map[string]JsonValue:
+		return JsonObject
+	case []JsonValue:
+		return JsonArray
+	case string:
+		s := strings.TrimSpace(v)
+		switch {
+		case s == "" || s == "null":
+			return JsonNull
+		case s == "true" || s == "false":
+			return JsonBool
+		case s[0] == '"':
+			return JsonString
+		default:
+			return JsonNumber
+		}
+	}
+	return JsonNull
+}
+
+// formatPath formats keys as a path like rows[0].count
+func formatPath(keys []interface{}) string {
+	var b strings.Builder
+	for _, key := range keys {
+		switch k := key.(type) {
+		case int:
+			fmt.Fprintf(&b, "[%d]", k)
+		default:
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			fmt.Fprintf(&b, "%v", k)
+		}
+	}
+	if b.Len() == 0 {
+		return "<root>"
+	}
+	return b.String()
+}
+
+// GetJsonValue returns the value at the path specified by keys, keys are strings for object members
+// and ints for array elements
+func (b *JsonValue) GetJsonValue(keys ...interface{}) (*JsonValue, error) {
+	value := b
+	for i, key := range keys {
+		switch k := key.(type) {
+		case string:
+			m, ok := value.value.(map[string]JsonValue)
+			if !ok {
+				return nil, newErrorf("json path %s: expected object, got %s", formatPath(keys[:i]), value.Type())
+			}
+			subValue, ok := m[k]
+			if !ok {
+				return nil, newErrorf("json path %s: key not found", formatPath(keys[:i+1]))
+			}
+			value = &subValue
+		case int:
+			arr, ok := value.value.([]JsonValue)
+			if !ok {
+				return nil, newErrorf("json path %s: expected array, got %s", formatPath(keys[:i]), value.Type())
+			}
+			if k < 0 || k >= len(arr) {
+				return nil, newErrorf("json path %s: index out of range, array length is %d", formatPath(keys[:i+1]), len(arr))
+			}
+			value = &arr[k]
+		default:
+			return nil, newErrorf("json path %s: invalid key type %T", formatPath(keys[:i]), key)
+		}
+	}
+	return value, nil
+}
+
+// Has returns true if the path specified by keys exists
+func (b *JsonValue) Has(keys ...interface{}) bool {
+	_, err := b.GetJsonValue(keys...)
+	return err == nil
+}
+
+// getNumber returns the text of a number, numbers encoded as strings are accepted
+// since the chain encodes 64 bit integers as strings when they can not be represented by a double
+func (b *JsonValue) getNumber(keys []interface{}) (string, error) {
+	value, err := b.GetJsonValue(keys...)
+	if err != nil {
+		return "", err
+	}
+
+	switch value.Type() {
+	case JsonNumber:
+		return strings.TrimSpace(value.value.(string)), nil
+	case JsonString:
+		s, _ := value.GetStringValue()
+		return s, nil
+	default:
+		return "", newErrorf("json path %s: expected number, got %s", formatPath(keys), value.Type())
+	}
+}
+
+func (b *JsonValue) GetInt(keys ...interface{}) (int64, error) {
+	s, err := b.getNumber(keys)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, newErrorf("json path %s: invalid int64 %q", formatPath(keys), s)
+	}
+	return n, nil
+}
+
+func (b *JsonValue) GetUint64(keys ...interface{}) (uint64, error) {
+	s, err := b.getNumber(keys)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, newErrorf("json path %s: invalid uint64 %q", formatPath(keys), s)
+	}
+	return n, nil
+}
+
+func (b *JsonValue) GetFloat(keys ...interface{}) (float64, error) {
+	s, err := b.getNumber(keys)
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, newErrorf("json path %s: invalid float %q", formatPath(keys), s)
+	}
+	return f, nil
+}
+
+func (b *JsonValue) GetBool(keys ...interface{}) (bool, error) {
+	value, err := b.GetJsonValue(keys...)
+	if err != nil {
+		return false, err
+	}
+	if value.Type() != JsonBool {
+		return false, newErrorf("json path %s: expected bool, got %s", formatPath(keys), value.Type())
+	}
+	return strings.TrimSpace(value.value.(string)) == "true", nil
+}
+
+func (b *JsonValue) GetArray(keys ...interface{}) ([]JsonValue, error) {
+	value, err := b.GetJsonValue(keys...)
+	if err != nil {
+		return nil, err
+	}
+	arr, ok := value.value.([]JsonValue)
+	if !ok {
+		return nil, newErrorf("json path %s: expected array, got %s", formatPath(keys), value.Type())
+	}
+	return arr, nil
+}
+
+// IsNull returns true if the value at the path is null, false is returned if the path does not exist
+func (b *JsonValue) IsNull(keys ...interface{}) bool {
+	value, err := b.GetJsonValue(keys...)
+	return err == nil && value.Type() == JsonNull
+}
+
+// Len returns the length of an array or the number of members of an object, 0 is returned for other types
+func (b *JsonValue) Len() int {
+	switch v := b.value.(type) {
+	case []JsonValue:
+		return len(v)
+	case map[string]JsonValue:
+		return len(v)
+	}
+	return 0
+}
+
+// Keys returns the sorted member names of an object, nil is returned for other types
+func (b *JsonValue) Keys() []string {
+	m, ok := b.value.(map[string]JsonValue)
+	if !ok {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Unmarshal decodes the value at the path specified by keys into v with encoding/json
+func (b *JsonValue) Unmarshal(v interface{}, keys ...interface{}) error {
+	value, err := b.GetJsonValue(keys...)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return newErrorf("json path %s: %v", formatPath(keys), err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return newErrorf("json path %s: %v", formatPath(keys), err)
+	}
+	return nil
+}
+
+// parsePath parses a path like processed.action_traces[0].console into keys accepted by GetJsonValue,
+// dots and brackets in member names are escaped with a backslash, an empty path refers to the value itself
+func parsePath(path string) ([]interface{}, error) {
+	var keys []interface{}
+	var key strings.Builder
+	// a member name is being parsed
+	inKey := false
+	// the last token is an index
+	afterIndex := false
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if afterIndex && c != '.' && c != '[' {
+			return nil, newErrorf("invalid json path %q: expected . or [ at %d", path, i)
+		}
+
+		switch c {
+		case '\\':
+			if i+1 >= len(path) {
+				return nil, newErrorf("invalid json path %q: trailing backslash", path)
+			}
+			i++
+			key.WriteByte(path[i])
+			inKey = true
+		case '.':
+			if !inKey && !afterIndex {
+				return nil, newErrorf("invalid json path %q: empty key at %d", path, i)
+			}
+			if inKey {
+				keys = append(keys, key.String())
+				key.Reset()
+				inKey = false
+			}
+			afterIndex = false
+			if i == len(path)-1 {
+				return nil, newErrorf("invalid json path %q: empty key at %d", path, i+1)
+			}
+		case '[':
+			if inKey {
+				keys = append(keys, key.String())
+				key.Reset()
+				inKey = false
+			}
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, newErrorf("invalid json path %q: missing ]", path)
+			}
+			index, err := strconv.Atoi(path[i+1 : i+end])
+			if err != nil || index < 0 {
+				return nil, newErrorf("invalid json path %q: invalid index %q", path, path[i+1:i+end])
+			}
+			keys = append(keys, index)
+			i += end
+			afterIndex = true
+		default:
+			key.WriteByte(c)
+			inKey = true
+		}
+	}
+	if inKey {
+		keys = append(keys, key.String())
+	}
+	return keys, nil
+}
+
+// Query returns the value at a path like processed.action_traces[0].console
+func (b *JsonValue) Query(path string) (*JsonValue, error) {
+	keys, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return b.GetJsonValue(keys...)
+}
+
+func toJsonValue(value interface{}) (*JsonValue, error) {
+	switch v := value.(type) {
+	case *JsonValue:
+		return v, nil
+	case JsonValue:
+		return &v, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, newError(err)
+	}
+	ret := &JsonValue{}
+	if err := json.Unmarshal(data, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Set sets the value at path to value encoded with encoding/json. Members are added to objects
+// if they do not exist, and elements are appended to arrays if the index equals to the length of an array.
+func (b *JsonValue) Set(path string, value interface{}) error {
+	keys, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+	newValue, err := toJsonValue(value)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		*b = *newValue
+		return nil
+	}
+	return b.update(keys, 0, newValue)
+}
+
+// Delete removes the member or the array element at path
+func (b *JsonValue) Delete(path string) error {
+	keys, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return newErrorf("invalid json path %q: can not delete the value itself", path)
+	}
+	return b.update(keys, 0, nil)
+}
+
+// update sets the value at keys[depth:] to newValue, or deletes it if newValue is nil,
+// raw of every value on the path is updated
+func (b *JsonValue) update(keys []interface{}, depth int, newValue *JsonValue) error {
+	last := depth == len(keys)-1
+	switch k := keys[depth].(type) {
+	case string:
+		m, ok := b.value.(map[string]JsonValue)
+		if !ok {
+			return newErrorf("json path %s: expected object, got %s", formatPath(keys[:depth]), b.Type())
+		}
+		subValue, ok := m[k]
+		switch {
+		case last && newValue != nil:
+			m[k] = *newValue
+		case !ok:
+			return newErrorf("json path %s: key not found", formatPath(keys[:depth+1]))
+		case last:
+			delete(m, k)
+		default:
+			if err := subValue.update(keys, depth+1, newValue); err != nil {
+				return err
+			}
+			m[k] = subValue
+		}
+	case int:
+		arr, ok := b.value.([]JsonValue)
+		if !ok {
+			return newErrorf("json path %s: expected array, got %s", formatPath(keys[:depth]), b.Type())
+		}
+		switch {
+		case last && newValue != nil && k == len(arr):
+			b.value = append(arr[:len(arr):len(arr)], *newValue)
+		case k < 0 || k >= len(arr):
+			return newErrorf("json path %s: index out of range, array length is %d", formatPath(keys[:depth+1]), len(arr))
+		case last && newValue != nil:
+			arr[k] = *newValue
+		case last:
+			b.value = append(append(make([]JsonValue, 0, len(arr)-1), arr[:k]...), arr[k+1:]...)
+		default:
+			if err := arr[k].update(keys, depth+1, newValue); err != nil {
+				return err
+			}
+		}
+	default:
+		return newErrorf("json path %s: invalid key type %T", formatPath(keys[:depth]), keys[depth])
+	}
+
+	raw, err := json.Marshal(b)
+	if err != nil {
+		return newError(err)
+	}
+	b.raw = raw
+	return nil
+}
+
+// String returns the value as indented JSON
+func (b *JsonValue) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return string(b.raw)
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, data, "", "  "); err != nil {
+		return string(data)
+	}
+	return out.String()
+}
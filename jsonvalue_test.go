@@ -0,0 +1,211 @@
+package chaintester
+
+import (
+	"strings"
+	"testing"
+)
+
+const testTableRows = `{
+	"rows": [
+		{"key": 1, "count": "18446744073709551615", "name": "alice", "ratio": 0.5, "enabled": true, "memo": null},
+		{"key": -2, "count": 3, "name": "bob", "ratio": 1, "enabled": false, "memo": "hello"}
+	],
+	"more": false,
+	"next_key": ""
+}`
+
+func TestJsonValueAccessors(t *testing.T) {
+	value := NewJsonValue([]byte(testTableRows))
+	if value == nil {
+		t.Fatal("invalid json")
+	}
+
+	if n, err := value.GetInt("rows", 1, "key"); err != nil || n != -2 {
+		t.Errorf("GetInt: %v %v", n, err)
+	}
+	if n, err := value.GetUint64("rows", 0, "count"); err != nil || n != 18446744073709551615 {
+		t.Errorf("GetUint64: %v %v", n, err)
+	}
+	if f, err := value.GetFloat("rows", 0, "ratio"); err != nil || f != 0.5 {
+		t.Errorf("GetFloat: %v %v", f, err)
+	}
+	if b, err := value.GetBool("rows", 0, "enabled"); err != nil || !b {
+		t.Errorf("GetBool: %v %v", b, err)
+	}
+	if arr, err := value.GetArray("rows"); err != nil || len(arr) != 2 {
+		t.Errorf("GetArray: %v %v", arr, err)
+	}
+
+	if value.Len() != 3 {
+		t.Errorf("Len: %d", value.Len())
+	}
+	if keys := value.Keys(); strings.Join(keys, ",") != "more,next_key,rows" {
+		t.Errorf("Keys: %v", keys)
+	}
+	if !value.Has("rows", 1, "memo") || value.Has("rows", 2) || value.Has("rows", 0, "foo") {
+		t.Error("Has")
+	}
+	if !value.IsNull("rows", 0, "memo") || value.IsNull("rows", 1, "memo") {
+		t.Error("IsNull")
+	}
+
+	for _, c := range []struct {
+		typ  JsonType
+		keys []interface{}
+	}{
+		{JsonObject, nil},
+		{JsonArray, []interface{}{"rows"}},
+		{JsonNumber, []interface{}{"rows", 0, "key"}},
+		{JsonString, []interface{}{"rows", 0, "name"}},
+		{JsonBool, []interface{}{"more"}},
+		{JsonNull, []interface{}{"rows", 0, "memo"}},
+	} {
+		v, err := value.GetJsonValue(c.keys...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Type() != c.typ {
+			t.Errorf("type of %v: %s, expected %s", c.keys, v.Type(), c.typ)
+		}
+	}
+
+	var rows []struct {
+		Key   int64      `json:"key"`
+		Count JsonUint64 `json:"count"`
+		Name  string     `json:"name"`
+	}
+	if err := value.Unmarshal(&rows, "rows"); err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || rows[0].Count != 18446744073709551615 || rows[1].Name != "bob" {
+		t.Errorf("Unmarshal: %+v", rows)
+	}
+}
+
+func TestJsonValueErrors(t *testing.T) {
+	value := NewJsonValue([]byte(testTableRows))
+
+	for _, c := range []struct {
+		err      error
+		expected string
+	}{
+		{second(value.GetInt("rows", 0, "name")), `json path rows[0].name: invalid int64 "alice"`},
+		{second(value.GetInt("rows", 0, "ratio")), `json path rows[0].ratio: invalid int64 "0.5"`},
+		{second(value.GetInt("rows", 0, "enabled")), "json path rows[0].enabled: expected number, got bool"},
+		{second(value.GetUint64("rows", 1, "key")), `json path rows[1].key: invalid uint64 "-2"`},
+		{second(value.GetBool("rows", 0, "memo")), "json path rows[0].memo: expected bool, got null"},
+		{second(value.GetArray("more")), "json path more: expected array, got bool"},
+		{second(value.GetInt("rows", 5, "key")), "json path rows[5]: index out of range, array length is 2"},
+		{second(value.GetInt("rows", 0, "foo")), "json path rows[0].foo: key not found"},
+		{second(value.GetInt("rows", "key")), "json path rows: expected object, got array"},
+		{value.Unmarshal(new(int), "rows", 0, "name"), "json path rows[0].name: json: cannot unmarshal"},
+	} {
+		if c.err == nil || !strings.HasPrefix(c.err.Error(), c.expected) {
+			t.Errorf("expected error %q, got %v", c.expected, c.err)
+		}
+	}
+}
+
+func second(_ interface{}, err error) error {
+	return err
+}
+
+func TestJsonValueStrings(t *testing.T) {
+	value := NewJsonValue([]byte(`{"console": "line1\nline2 é \"quoted\""}`))
+	s, err := value.GetString("console")
+	if err != nil || s != "line1\nline2 é \"quoted\"" {
+		t.Errorf("GetString: %q %v", s, err)
+	}
+
+	value = &JsonValue{}
+	value.SetValue("hello")
+	if data, err := value.MarshalJSON(); err != nil || string(data) != `"hello"` {
+		t.Errorf("MarshalJSON: %s %v", data, err)
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	for path, expected := range map[string][]interface{}{
+		"":                                   nil,
+		"more":                               {"more"},
+		"processed.action_traces[0].console": {"processed", "action_traces", 0, "console"},
+		"[1][2].a":                           {1, 2, "a"},
+		`eosio\.token.balance`:               {"eosio.token", "balance"},
+	} {
+		keys, err := parsePath(path)
+		if err != nil {
+			t.Errorf("%q: %v", path, err)
+			continue
+		}
+		if formatPath(keys) != formatPath(expected) || len(keys) != len(expected) {
+			t.Errorf("%q: %v, expected %v", path, keys, expected)
+		}
+	}
+
+	for _, path := range []string{".a", "a.", "a..b", "a[", "a[x]", "a[-1]", "a[0]b", `a\`} {
+		if _, err := parsePath(path); err == nil {
+			t.Errorf("invalid path %q accepted", path)
+		}
+	}
+}
+
+func TestJsonValueQuery(t *testing.T) {
+	value := NewJsonValue([]byte(`{"processed": {"action_traces": [{"console": "hello"}, {"console": ""}]}}`))
+	console, err := value.Query("processed.action_traces[0].console")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := console.GetStringValue(); s != "hello" {
+		t.Errorf("Query: %s", s)
+	}
+	if _, err := value.Query("processed.action_traces[2].console"); err == nil || !strings.Contains(err.Error(), "processed.action_traces[2]") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestJsonValueSetDelete(t *testing.T) {
+	value := NewJsonValue([]byte(`{"a": {"b": [1, 2, 3]}, "c": "x"}`))
+
+	for _, c := range []struct {
+		path  string
+		value interface{}
+	}{
+		{"a.b[0]", 10},
+		{"a.b[3]", "four"},
+		{"a.d", map[string]bool{"e": true}},
+		{"c", nil},
+	} {
+		if err := value.Set(c.path, c.value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := value.Delete("a.b[1]"); err != nil {
+		t.Fatal(err)
+	}
+	if err := value.Delete("c"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"a":{"b":[10,3,"four"],"d":{"e":true}}}`
+	if value.ToString() != expected {
+		t.Errorf("raw: %s, expected %s", value.ToString(), expected)
+	}
+	b, _ := value.Query("a")
+	if b.ToString() != `{"b":[10,3,"four"],"d":{"e":true}}` {
+		t.Errorf("raw of sub value: %s", b.ToString())
+	}
+
+	if err := value.Set("a.b[5]", 1); err == nil {
+		t.Error("out of range index accepted")
+	}
+	if err := value.Set("x.y", 1); err == nil {
+		t.Error("missing parent accepted")
+	}
+	if err := value.Delete("a.x"); err == nil {
+		t.Error("missing key deleted")
+	}
+
+	if value.String() != "{\n  \"a\": {\n    \"b\": [\n      10,\n      3,\n      \"four\"\n    ],\n    \"d\": {\n      \"e\": true\n    }\n  }\n}" {
+		t.Errorf("String: %s", value.String())
+	}
+}
@@ -0,0 +1,108 @@
+package keys
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/ripemd160"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var base58Index = func() [256]int {
+	var index [256]int
+	for i := range index {
+		index[i] = -1
+	}
+	for i := 0; i < len(base58Alphabet); i++ {
+		index[base58Alphabet[i]] = i
+	}
+	return index
+}()
+
+func base58Encode(data []byte) string {
+	n := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+func base58Decode(s string) ([]byte, error) {
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for i := 0; i < len(s); i++ {
+		v := base58Index[s[i]]
+		if v < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", s[i])
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(v)))
+	}
+
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
+
+func ripemd160Checksum(data []byte, suffix string) []byte {
+	h := ripemd160.New()
+	h.Write(data)
+	h.Write([]byte(suffix))
+	return h.Sum(nil)[:4]
+}
+
+func sha256d(data []byte) []byte {
+	h := sha256.Sum256(data)
+	h = sha256.Sum256(h[:])
+	return h[:]
+}
+
+// encode encodes data in the PUB_K1_/PVT_R1_/SIG_K1_ style format
+func encode(prefix string, keyType KeyType, data []byte) string {
+	checksum := ripemd160Checksum(data, string(keyType))
+	return prefix + "_" + string(keyType) + "_" + base58Encode(append(append([]byte{}, data...), checksum...))
+}
+
+// decode decodes a string encoded by encode, returns the key type and data
+func decode(prefix string, s string) (KeyType, []byte, error) {
+	parts := strings.SplitN(s, "_", 3)
+	if len(parts) != 3 || parts[0] != prefix {
+		return "", nil, fmt.Errorf("invalid format: %s", s)
+	}
+
+	keyType := KeyType(parts[1])
+	data, err := base58Decode(parts[2])
+	if err != nil {
+		return "", nil, err
+	}
+	if len(data) < 4 {
+		return "", nil, fmt.Errorf("invalid data: %s", s)
+	}
+
+	data, checksum := data[:len(data)-4], data[len(data)-4:]
+	if !bytes.Equal(checksum, ripemd160Checksum(data, string(keyType))) {
+		return "", nil, fmt.Errorf("invalid checksum: %s", s)
+	}
+	return keyType, data, nil
+}
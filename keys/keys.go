@@ -0,0 +1,250 @@
+// Package keys implements EOSIO keys and signatures in pure Go: key generation,
+// the legacy EOS.../5K... and the PUB_K1_/PVT_K1_/SIG_K1_ string formats,
+// signing of digests and public key recovery.
+package keys
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+type KeyType string
+
+const (
+	K1 KeyType = "K1"
+	R1 KeyType = "R1"
+	WA KeyType = "WA"
+)
+
+// LegacyPublicKeyPrefix is the prefix of K1 public keys in the legacy format
+const LegacyPublicKeyPrefix = "EOS"
+
+// index of the key type in the packed public_key and signature variants
+func (t KeyType) index() (byte, error) {
+	switch t {
+	case K1:
+		return 0, nil
+	case R1:
+		return 1, nil
+	case WA:
+		return 2, nil
+	default:
+		return 0, fmt.Errorf("unknown key type: %s", t)
+	}
+}
+
+type PrivateKey struct {
+	Type KeyType
+	k1   *secp256k1.PrivateKey
+	r1   *ecdsa.PrivateKey
+}
+
+// NewPrivateKey generates a K1 or R1 private key
+func NewPrivateKey(keyType KeyType) (*PrivateKey, error) {
+	switch keyType {
+	case K1:
+		key, err := secp256k1.GeneratePrivateKey()
+		if err != nil {
+			return nil, err
+		}
+		return &PrivateKey{Type: K1, k1: key}, nil
+	case R1:
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		return &PrivateKey{Type: R1, r1: key}, nil
+	default:
+		return nil, fmt.Errorf("can not generate key of type %s", keyType)
+	}
+}
+
+// NewPrivateKeyFromBytes returns a K1 or R1 private key of a 32 bytes scalar
+func NewPrivateKeyFromBytes(keyType KeyType, data []byte) (*PrivateKey, error) {
+	if len(data) != 32 {
+		return nil, fmt.Errorf("invalid private key size: %d", len(data))
+	}
+
+	switch keyType {
+	case K1:
+		var scalar secp256k1.ModNScalar
+		if overflow := scalar.SetByteSlice(data); overflow || scalar.IsZero() {
+			return nil, fmt.Errorf("invalid private key")
+		}
+		return &PrivateKey{Type: K1, k1: secp256k1.NewPrivateKey(&scalar)}, nil
+	case R1:
+		curve := elliptic.P256()
+		d := new(big.Int).SetBytes(data)
+		if d.Sign() == 0 || d.Cmp(curve.Params().N) >= 0 {
+			return nil, fmt.Errorf("invalid private key")
+		}
+		key := &ecdsa.PrivateKey{D: d}
+		key.Curve = curve
+		key.X, key.Y = curve.ScalarBaseMult(data)
+		return &PrivateKey{Type: R1, r1: key}, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type: %s", keyType)
+	}
+}
+
+// ParsePrivateKey parses a private key in the legacy WIF or the PVT_K1_/PVT_R1_ format
+func ParsePrivateKey(s string) (*PrivateKey, error) {
+	if strings.HasPrefix(s, "PVT_") {
+		keyType, data, err := decode("PVT", s)
+		if err != nil {
+			return nil, err
+		}
+		return NewPrivateKeyFromBytes(keyType, data)
+	}
+
+	data, err := base58Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != 37 || data[0] != 0x80 {
+		return nil, fmt.Errorf("invalid private key: %s", s)
+	}
+	if !bytes.Equal(data[33:], sha256d(data[:33])[:4]) {
+		return nil, fmt.Errorf("invalid checksum: %s", s)
+	}
+	return NewPrivateKeyFromBytes(K1, data[1:33])
+}
+
+func (k *PrivateKey) Bytes() []byte {
+	if k.Type == K1 {
+		return k.k1.Serialize()
+	}
+	return k.r1.D.FillBytes(make([]byte, 32))
+}
+
+// String returns the private key in PVT_K1_/PVT_R1_ format
+func (k *PrivateKey) String() string {
+	return encode("PVT", k.Type, k.Bytes())
+}
+
+// LegacyString returns a K1 private key in the legacy WIF format,
+// R1 keys have no legacy format and are returned in PVT_R1_ format
+func (k *PrivateKey) LegacyString() string {
+	if k.Type != K1 {
+		return k.String()
+	}
+	data := append([]byte{0x80}, k.Bytes()...)
+	return base58Encode(append(data, sha256d(data)[:4]...))
+}
+
+func (k *PrivateKey) PublicKey() *PublicKey {
+	if k.Type == K1 {
+		return &PublicKey{Type: K1, Data: k.k1.PubKey().SerializeCompressed()}
+	}
+	return &PublicKey{Type: R1, Data: elliptic.MarshalCompressed(k.r1.Curve, k.r1.X, k.r1.Y)}
+}
+
+// Sign signs a sha256 digest, K1 signatures are deterministic and canonical
+func (k *PrivateKey) Sign(digest []byte) (*Signature, error) {
+	if len(digest) != 32 {
+		return nil, fmt.Errorf("invalid digest size: %d", len(digest))
+	}
+
+	if k.Type == K1 {
+		return &Signature{Type: K1, Data: signK1(k.k1, digest)}, nil
+	}
+	sig, err := signR1(k.r1, digest)
+	if err != nil {
+		return nil, err
+	}
+	return &Signature{Type: R1, Data: sig}, nil
+}
+
+type PublicKey struct {
+	Type KeyType
+	// compressed point of K1 and R1 keys, serialized key of WA keys
+	Data []byte
+}
+
+// ParsePublicKey parses a public key in the legacy EOS... or the PUB_K1_/PUB_R1_/PUB_WA_ format
+func ParsePublicKey(s string) (*PublicKey, error) {
+	if strings.HasPrefix(s, "PUB_") {
+		keyType, data, err := decode("PUB", s)
+		if err != nil {
+			return nil, err
+		}
+		key := &PublicKey{Type: keyType, Data: data}
+		if err := key.validate(); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+
+	if !strings.HasPrefix(s, LegacyPublicKeyPrefix) {
+		return nil, fmt.Errorf("invalid public key: %s", s)
+	}
+	data, err := base58Decode(s[len(LegacyPublicKeyPrefix):])
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != 37 || !bytes.Equal(data[33:], ripemd160Checksum(data[:33], "")) {
+		return nil, fmt.Errorf("invalid public key: %s", s)
+	}
+	key := &PublicKey{Type: K1, Data: data[:33]}
+	if err := key.validate(); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (k *PublicKey) validate() error {
+	switch k.Type {
+	case K1:
+		if _, err := secp256k1.ParsePubKey(k.Data); err != nil || len(k.Data) != 33 {
+			return fmt.Errorf("invalid K1 public key")
+		}
+	case R1:
+		if len(k.Data) != 33 {
+			return fmt.Errorf("invalid R1 public key")
+		}
+		if x, _ := elliptic.UnmarshalCompressed(elliptic.P256(), k.Data); x == nil {
+			return fmt.Errorf("invalid R1 public key")
+		}
+	case WA:
+		if len(k.Data) < 33 {
+			return fmt.Errorf("invalid WA public key")
+		}
+	default:
+		return fmt.Errorf("unknown key type: %s", k.Type)
+	}
+	return nil
+}
+
+// String returns the public key in PUB_K1_/PUB_R1_/PUB_WA_ format
+func (k *PublicKey) String() string {
+	return encode("PUB", k.Type, k.Data)
+}
+
+// LegacyString returns a K1 public key in the legacy EOS... format,
+// keys of other types are returned in the PUB_R1_/PUB_WA_ format
+func (k *PublicKey) LegacyString() string {
+	if k.Type != K1 {
+		return k.String()
+	}
+	return LegacyPublicKeyPrefix + base58Encode(append(append([]byte{}, k.Data...), ripemd160Checksum(k.Data, "")...))
+}
+
+func (k *PublicKey) Equal(other *PublicKey) bool {
+	return other != nil && k.Type == other.Type && bytes.Equal(k.Data, other.Data)
+}
+
+// Pack serializes the public key as the public_key variant of the chain
+func (k *PublicKey) Pack() ([]byte, error) {
+	index, err := k.Type.index()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{index}, k.Data...), nil
+}
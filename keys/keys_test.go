@@ -0,0 +1,156 @@
+package keys
+
+import (
+	"crypto/sha256"
+	"testing"
+)
+
+const (
+	testPrivateKey = "5KQwrPbwdL6PhXujxW37FSSQZ1JiwsST4cqQzDeyXtP79zkvFD3"
+	testPublicKey  = "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"
+)
+
+func TestBase58(t *testing.T) {
+	for _, data := range [][]byte{{}, {0}, {0, 0, 1}, {0xff, 0xfe}, []byte("hello world")} {
+		decoded, err := base58Decode(base58Encode(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(decoded) != string(data) {
+			t.Fatalf("bad base58 round trip: %x != %x", decoded, data)
+		}
+	}
+	if base58Encode([]byte("hello world")) != "StV1DL6CwTryKyV" {
+		t.Fatalf("bad base58: %s", base58Encode([]byte("hello world")))
+	}
+	if _, err := base58Decode("0OIl"); err == nil {
+		t.Fatal("invalid base58 should fail to decode")
+	}
+}
+
+func TestLegacyFormat(t *testing.T) {
+	key, err := ParsePrivateKey(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.LegacyString() != testPrivateKey {
+		t.Fatalf("bad private key: %s", key.LegacyString())
+	}
+	if key.PublicKey().LegacyString() != testPublicKey {
+		t.Fatalf("bad public key: %s", key.PublicKey().LegacyString())
+	}
+
+	key2, err := ParsePrivateKey(key.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key2.LegacyString() != testPrivateKey {
+		t.Fatalf("bad private key: %s", key2.LegacyString())
+	}
+
+	pub, err := ParsePublicKey(testPublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub2, err := ParsePublicKey(pub.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pub.Equal(pub2) || !pub.Equal(key.PublicKey()) {
+		t.Fatalf("bad public key: %s", pub2)
+	}
+
+	invalid := []string{
+		"",
+		"5KQwrPbwdL6PhXujxW37FSSQZ1JiwsST4cqQzDeyXtP79zkvFD4",
+		"PVT_K1_1111",
+		"PVT_WA_" + testPrivateKey,
+	}
+	for _, s := range invalid {
+		if _, err := ParsePrivateKey(s); err == nil {
+			t.Errorf("private key %q should be invalid", s)
+		}
+	}
+
+	invalid = []string{
+		"",
+		"EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CW",
+		"PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV",
+		"PUB_XX_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV",
+	}
+	for _, s := range invalid {
+		if _, err := ParsePublicKey(s); err == nil {
+			t.Errorf("public key %q should be invalid", s)
+		}
+	}
+}
+
+func TestSignAndRecover(t *testing.T) {
+	for _, keyType := range []KeyType{K1, R1} {
+		key, err := NewPrivateKey(keyType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key.Type != keyType || key.PublicKey().Type != keyType {
+			t.Fatalf("bad key type: %s", key.Type)
+		}
+
+		parsed, err := ParsePrivateKey(key.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !parsed.PublicKey().Equal(key.PublicKey()) {
+			t.Fatalf("bad parsed key: %s", parsed)
+		}
+
+		for i := 0; i < 16; i++ {
+			digest := sha256.Sum256([]byte{byte(i)})
+			sig, err := key.Sign(digest[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if keyType == K1 && !IsCanonical(sig.Data) {
+				t.Fatalf("signature is not canonical: %s", sig)
+			}
+
+			parsedSig, err := ParseSignature(sig.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			pub, err := parsedSig.RecoverPublicKey(digest[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !pub.Equal(key.PublicKey()) {
+				t.Fatalf("bad recovered key: %s", pub)
+			}
+
+			other := sha256.Sum256([]byte{byte(i), 1})
+			if sig.Verify(other[:], key.PublicKey()) {
+				t.Fatal("signature should not verify another digest")
+			}
+		}
+
+		packed, err := key.PublicKey().Pack()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(packed) != 34 || (keyType == K1 && packed[0] != 0) || (keyType == R1 && packed[0] != 1) {
+			t.Fatalf("bad packed public key: %x", packed)
+		}
+	}
+
+	if _, err := NewPrivateKey(WA); err == nil {
+		t.Fatal("WA keys can not be generated")
+	}
+}
+
+func TestDeterministicK1Signature(t *testing.T) {
+	key, _ := ParsePrivateKey(testPrivateKey)
+	digest := sha256.Sum256([]byte("hello"))
+	sig1, _ := key.Sign(digest[:])
+	sig2, _ := key.Sign(digest[:])
+	if sig1.String() != sig2.String() {
+		t.Fatalf("K1 signatures should be deterministic: %s %s", sig1, sig2)
+	}
+}
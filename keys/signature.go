@@ -0,0 +1,204 @@
+package keys
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	k1ecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+type Signature struct {
+	Type KeyType
+	// compact signature of K1 and R1 keys: recovery code, r and s
+	Data []byte
+}
+
+// ParseSignature parses a signature in SIG_K1_/SIG_R1_/SIG_WA_ format
+func ParseSignature(s string) (*Signature, error) {
+	keyType, data, err := decode("SIG", s)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := keyType.index(); err != nil {
+		return nil, err
+	}
+	if keyType != WA && len(data) != 65 {
+		return nil, fmt.Errorf("invalid signature: %s", s)
+	}
+	return &Signature{Type: keyType, Data: data}, nil
+}
+
+func (s *Signature) String() string {
+	return encode("SIG", s.Type, s.Data)
+}
+
+// Pack serializes the signature as the signature variant of the chain
+func (s *Signature) Pack() ([]byte, error) {
+	index, err := s.Type.index()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{index}, s.Data...), nil
+}
+
+// RecoverPublicKey recovers the public key of a K1 or R1 signature of digest
+func (s *Signature) RecoverPublicKey(digest []byte) (*PublicKey, error) {
+	switch s.Type {
+	case K1:
+		pub, _, err := k1ecdsa.RecoverCompact(s.Data, digest)
+		if err != nil {
+			return nil, err
+		}
+		return &PublicKey{Type: K1, Data: pub.SerializeCompressed()}, nil
+	case R1:
+		pub, err := recoverR1(s.Data, digest)
+		if err != nil {
+			return nil, err
+		}
+		return &PublicKey{Type: R1, Data: elliptic.MarshalCompressed(pub.Curve, pub.X, pub.Y)}, nil
+	default:
+		return nil, fmt.Errorf("can not recover public key of %s signature", s.Type)
+	}
+}
+
+// Verify reports whether the signature of digest is signed by pub
+func (s *Signature) Verify(digest []byte, pub *PublicKey) bool {
+	recovered, err := s.RecoverPublicKey(digest)
+	return err == nil && recovered.Equal(pub)
+}
+
+// IsCanonical reports whether a compact K1 signature is accepted by the chain
+func IsCanonical(sig []byte) bool {
+	return len(sig) == 65 &&
+		sig[1]&0x80 == 0 && !(sig[1] == 0 && sig[2]&0x80 == 0) &&
+		sig[33]&0x80 == 0 && !(sig[33] == 0 && sig[34]&0x80 == 0)
+}
+
+// signK1 signs digest with a deterministic nonce, the nonce is changed until the signature is canonical
+func signK1(privKey *secp256k1.PrivateKey, digest []byte) []byte {
+	var keyBytes [32]byte
+	privKey.Key.PutBytes(&keyBytes)
+
+	var extra [32]byte
+	for counter := uint32(0); ; counter++ {
+		binary.LittleEndian.PutUint32(extra[:], counter)
+		k := secp256k1.NonceRFC6979(keyBytes[:], digest, extra[:], nil, 0)
+
+		var kG secp256k1.JacobianPoint
+		secp256k1.ScalarBaseMultNonConst(k, &kG)
+		kG.ToAffine()
+
+		var r secp256k1.ModNScalar
+		overflow := r.SetByteSlice(kG.X.Bytes()[:])
+		if r.IsZero() {
+			continue
+		}
+		recoveryCode := byte(kG.Y.IsOddBit())
+		if overflow {
+			recoveryCode |= 2
+		}
+
+		var e secp256k1.ModNScalar
+		e.SetByteSlice(digest)
+		kInv := new(secp256k1.ModNScalar).InverseValNonConst(k)
+		s := new(secp256k1.ModNScalar).Mul2(&privKey.Key, &r).Add(&e).Mul(kInv)
+		if s.IsZero() {
+			continue
+		}
+		if s.IsOverHalfOrder() {
+			s.Negate()
+			recoveryCode ^= 1
+		}
+
+		sig := make([]byte, 65)
+		sig[0] = 27 + 4 + recoveryCode
+		r.PutBytesUnchecked(sig[1:33])
+		s.PutBytesUnchecked(sig[33:65])
+		if IsCanonical(sig) {
+			return sig
+		}
+	}
+}
+
+// signR1 signs digest with a P-256 key, the recovery code is found by recovering the public key
+func signR1(privKey *ecdsa.PrivateKey, digest []byte) ([]byte, error) {
+	curve := privKey.Curve
+	halfOrder := new(big.Int).Rsh(curve.Params().N, 1)
+
+	r, s, err := ecdsa.Sign(rand.Reader, privKey, digest)
+	if err != nil {
+		return nil, err
+	}
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(curve.Params().N, s)
+	}
+
+	sig := make([]byte, 65)
+	r.FillBytes(sig[1:33])
+	s.FillBytes(sig[33:65])
+	for recoveryCode := byte(0); recoveryCode < 4; recoveryCode++ {
+		sig[0] = 27 + 4 + recoveryCode
+		pub, err := recoverR1(sig, digest)
+		if err == nil && pub.X.Cmp(privKey.X) == 0 && pub.Y.Cmp(privKey.Y) == 0 {
+			return sig, nil
+		}
+	}
+	return nil, fmt.Errorf("can not find recovery code of signature")
+}
+
+// recoverR1 recovers the P-256 public key from a compact signature
+func recoverR1(sig []byte, digest []byte) (*ecdsa.PublicKey, error) {
+	if len(sig) != 65 || sig[0] < 27 || sig[0] > 27+7 {
+		return nil, fmt.Errorf("invalid signature")
+	}
+
+	curve := elliptic.P256()
+	params := curve.Params()
+	recoveryCode := (sig[0] - 27) & 3
+	r := new(big.Int).SetBytes(sig[1:33])
+	s := new(big.Int).SetBytes(sig[33:65])
+	if r.Sign() == 0 || s.Sign() == 0 || r.Cmp(params.N) >= 0 || s.Cmp(params.N) >= 0 {
+		return nil, fmt.Errorf("invalid signature")
+	}
+
+	x := new(big.Int).Set(r)
+	if recoveryCode&2 != 0 {
+		x.Add(x, params.N)
+	}
+	if x.Cmp(params.P) >= 0 {
+		return nil, fmt.Errorf("invalid signature")
+	}
+
+	// y^2 = x^3 - 3x + b
+	y := new(big.Int).Exp(x, big.NewInt(3), params.P)
+	y.Sub(y, new(big.Int).Mul(x, big.NewInt(3)))
+	y.Add(y, params.B)
+	y.Mod(y, params.P)
+	if y.ModSqrt(y, params.P) == nil {
+		return nil, fmt.Errorf("invalid signature")
+	}
+	if y.Bit(0) != uint(recoveryCode&1) {
+		y.Sub(params.P, y)
+	}
+
+	// Q = r^-1 (sR - eG)
+	e := new(big.Int).SetBytes(digest)
+	rInv := new(big.Int).ModInverse(r, params.N)
+	u1 := new(big.Int).Mul(e, rInv)
+	u1.Neg(u1).Mod(u1, params.N)
+	u2 := new(big.Int).Mul(s, rInv)
+	u2.Mod(u2, params.N)
+
+	x1, y1 := curve.ScalarBaseMult(u1.Bytes())
+	x2, y2 := curve.ScalarMult(x, y, u2.Bytes())
+	qx, qy := curve.Add(x1, y1, x2, y2)
+	if qx.Sign() == 0 && qy.Sign() == 0 {
+		return nil, fmt.Errorf("invalid signature")
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: qx, Y: qy}, nil
+}
@@ -0,0 +1,146 @@
+package chaintester
+
+import "github.com/uuosio/chaintester/keys"
+
+// Signer signs transaction digests with a private key. Custom signers can be added
+// to a KeyStore for keys which can not be imported from a string, such as WebAuthn keys.
+type Signer interface {
+	PublicKey() string
+	// Sign signs a sha256 digest and returns the signature in SIG_K1_/SIG_R1_/SIG_WA_ format
+	Sign(digest []byte) (string, error)
+}
+
+type keySigner struct {
+	key *keys.PrivateKey
+}
+
+func (s *keySigner) PublicKey() string {
+	return s.key.PublicKey().LegacyString()
+}
+
+func (s *keySigner) Sign(digest []byte) (string, error) {
+	sig, err := s.key.Sign(digest)
+	if err != nil {
+		return "", err
+	}
+	return sig.String(), nil
+}
+
+// NewSigner returns a signer of a K1 private key in legacy WIF or PVT_K1_ format,
+// or of a R1 private key in PVT_R1_ format
+func NewSigner(privKey string) (Signer, error) {
+	key, err := keys.ParsePrivateKey(privKey)
+	if err != nil {
+		return nil, newError(err)
+	}
+	return &keySigner{key}, nil
+}
+
+// normalizePublicKey converts a public key to the PUB_K1_/PUB_R1_/PUB_WA_ format,
+// so that keys in the legacy format can be compared with them
+func normalizePublicKey(pubKey string) (string, error) {
+	key, err := keys.ParsePublicKey(pubKey)
+	if err != nil {
+		return "", newError(err)
+	}
+	return key.String(), nil
+}
+
+// KeyStore holds the keys used to sign transactions locally
+type KeyStore struct {
+	// signers by public keys in PUB_K1_/PUB_R1_/PUB_WA_ format
+	signers map[string]Signer
+	keys    []string
+}
+
+func NewKeyStore() *KeyStore {
+	return &KeyStore{signers: make(map[string]Signer)}
+}
+
+// clone returns a copy of the key store, signers added to the copy are not added to ks
+func (ks *KeyStore) clone() *KeyStore {
+	if ks == nil {
+		return nil
+	}
+	c := NewKeyStore()
+	for key, signer := range ks.signers {
+		c.signers[key] = signer
+	}
+	c.keys = append(c.keys, ks.keys...)
+	return c
+}
+
+// Add adds a signer, a signer with the same public key is replaced
+func (ks *KeyStore) Add(signer Signer) error {
+	key, err := normalizePublicKey(signer.PublicKey())
+	if err != nil {
+		return err
+	}
+
+	if _, ok := ks.signers[key]; !ok {
+		ks.keys = append(ks.keys, signer.PublicKey())
+	}
+	ks.signers[key] = signer
+	return nil
+}
+
+// Import adds a private key and returns its public key
+func (ks *KeyStore) Import(privKey string) (string, error) {
+	signer, err := NewSigner(privKey)
+	if err != nil {
+		return "", err
+	}
+	if err := ks.Add(signer); err != nil {
+		return "", err
+	}
+	return signer.PublicKey(), nil
+}
+
+func (ks *KeyStore) Remove(pubKey string) bool {
+	key, err := normalizePublicKey(pubKey)
+	if err != nil {
+		return false
+	}
+	if _, ok := ks.signers[key]; !ok {
+		return false
+	}
+	delete(ks.signers, key)
+
+	for i, k := range ks.keys {
+		if normalized, _ := normalizePublicKey(k); normalized == key {
+			ks.keys = append(ks.keys[:i], ks.keys[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// Keys returns the public keys in the order they were added
+func (ks *KeyStore) Keys() []string {
+	return append([]string{}, ks.keys...)
+}
+
+// Signer returns the signer of pubKey, which can be in either the legacy or the PUB_K1_ format
+func (ks *KeyStore) Signer(pubKey string) (Signer, bool) {
+	key, err := normalizePublicKey(pubKey)
+	if err != nil {
+		return nil, false
+	}
+	signer, ok := ks.signers[key]
+	return signer, ok
+}
+
+// KeyStore returns the key store used by SignTransaction
+func (p *ChainTester) KeyStore() *KeyStore {
+	if p.keyStore == nil {
+		p.keyStore = NewKeyStore()
+	}
+	return p.keyStore
+}
+
+// SetAutoSign enables or disables signing of transactions by the server. Transactions
+// pushed without signatures fail with missing authorization while auto signing is disabled,
+// the same way they fail on a real chain.
+func (p *ChainTester) SetAutoSign(enable bool) error {
+	return p.IPCChainTesterClient.EnableAutoSign(defaultCtx, p.id, enable)
+}
@@ -0,0 +1,177 @@
+package chaintester
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/uuosio/chaintester/keys"
+)
+
+const (
+	testPrivateKey = "5KQwrPbwdL6PhXujxW37FSSQZ1JiwsST4cqQzDeyXtP79zkvFD3"
+	testPublicKey  = "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"
+)
+
+func TestSigner(t *testing.T) {
+	signer, err := NewSigner(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer.PublicKey() != testPublicKey {
+		t.Fatalf("bad public key: %s", signer.PublicKey())
+	}
+
+	key, err := keys.NewPrivateKey(keys.R1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err = NewSigner(key.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer.PublicKey() != key.PublicKey().String() {
+		t.Fatalf("bad public key: %s", signer.PublicKey())
+	}
+
+	digest := sha256.Sum256([]byte("hello"))
+	sig, err := signer.Sign(digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := keys.ParseSignature(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !signature.Verify(digest[:], key.PublicKey()) {
+		t.Fatalf("bad signature: %s", sig)
+	}
+
+	if _, err := NewSigner("PVT_WA_" + testPrivateKey); err == nil {
+		t.Error("WebAuthn keys can not be imported")
+	}
+}
+
+func TestKeyStore(t *testing.T) {
+	ks := NewKeyStore()
+	pubKey, err := ks.Import(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pubKey != testPublicKey {
+		t.Fatalf("bad public key: %s", pubKey)
+	}
+	if _, err := ks.Import(testPrivateKey); err != nil {
+		t.Fatal(err)
+	}
+	if keys := ks.Keys(); len(keys) != 1 || keys[0] != testPublicKey {
+		t.Fatalf("bad keys: %v", keys)
+	}
+
+	clone := ks.clone()
+	key, err := keys.NewPrivateKey(keys.R1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clone.Import(key.String()); err != nil {
+		t.Fatal(err)
+	}
+	if len(ks.Keys()) != 1 || len(clone.Keys()) != 2 {
+		t.Fatalf("keys of the copy are shared: %v %v", ks.Keys(), clone.Keys())
+	}
+
+	normalized, _ := normalizePublicKey(testPublicKey)
+	if _, ok := ks.Signer(normalized); !ok {
+		t.Fatal("signer should be found by public key in PUB_K1_ format")
+	}
+
+	if !ks.Remove(normalized) || len(ks.Keys()) != 0 {
+		t.Fatalf("key should be removed: %v", ks.Keys())
+	}
+	if _, ok := ks.Signer(testPublicKey); ok {
+		t.Fatal("removed key should not be found")
+	}
+}
+
+func TestSignedTransaction(t *testing.T) {
+	trx := &signedTransaction{
+		expiration:     time.Unix(1600000000, 0),
+		RefBlockNum:    1,
+		RefBlockPrefix: 2,
+		Actions: []*transactionAction{{
+			Account:       "eosio.token",
+			Name:          "transfer",
+			Authorization: []PermissionLevel{{"alice", "active"}},
+			Data:          "0a0b",
+		}},
+		ContextFreeData: []string{"0c"},
+	}
+	chainID := "8a34ec7df1b8cd06ff4a8abbaa7cc50300823350cadc59ab296cb00d104d2b8f"
+	signed, err := newSignedTransaction(trx, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packed := "00105e5f" + "0100" + "02000000" + "000000" + "00" +
+		"01" + "00a6823403ea3055" + "000000572d3ccdcd" + "01" + "0000000000855c34" + "00000000a8ed3232" + "020a0b" + "00"
+	if hex.EncodeToString(signed.PackedTrx) != packed {
+		t.Fatalf("bad packed transaction: %x", signed.PackedTrx)
+	}
+	if len(signed.ID) != 64 || len(signed.Digest) != 32 {
+		t.Fatalf("bad signed transaction: %+v", signed)
+	}
+
+	signer, _ := NewSigner(testPrivateKey)
+	if err := signed.Sign(signer); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := signed.RecoverKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	normalized, _ := normalizePublicKey(testPublicKey)
+	if len(keys) != 1 || keys[0] != normalized {
+		t.Fatalf("bad recovered keys: %v", keys)
+	}
+
+	trx.ContextFreeData = nil
+	unsigned, err := newSignedTransaction(trx, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unsigned.ID != signed.ID || string(unsigned.Digest) == string(signed.Digest) {
+		t.Fatal("context free data should change the digest but not the id")
+	}
+
+	unpacked, err := unpackTransaction(signed.PackedTrx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unpacked.Expiration != "2020-09-13T12:26:40" || unpacked.RefBlockNum != 1 || unpacked.RefBlockPrefix != 2 {
+		t.Fatalf("bad unpacked transaction: %+v", unpacked)
+	}
+	if len(unpacked.Actions) != 1 || unpacked.Actions[0].Account != "eosio.token" || unpacked.Actions[0].Name != "transfer" ||
+		len(unpacked.Actions[0].Authorization) != 1 || unpacked.Actions[0].Authorization[0] != trx.Actions[0].Authorization[0] ||
+		unpacked.Actions[0].Data != "0a0b" {
+		t.Fatalf("bad unpacked actions: %+v", unpacked.Actions)
+	}
+	repacked, err := unpacked.pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(repacked, signed.PackedTrx) {
+		t.Fatalf("bad repacked transaction: %x", repacked)
+	}
+	for _, invalid := range []string{"", packed[:len(packed)-2], packed + "00", packed[:len(packed)-2] + "01"} {
+		data, _ := hex.DecodeString(invalid)
+		if _, err := unpackTransaction(data); err == nil {
+			t.Errorf("packed transaction %s should be invalid", invalid)
+		}
+	}
+
+	trx.Actions[0].Name = "Transfer"
+	if _, err := newSignedTransaction(trx, chainID); err == nil {
+		t.Fatal("invalid action name should fail to pack")
+	}
+}
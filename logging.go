@@ -0,0 +1,135 @@
+package chaintester
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is the importance of a log message, with the values of slog.Level
+type Level int
+
+const (
+	LevelDebug Level = -4
+	LevelInfo  Level = 0
+	LevelWarn  Level = 4
+	LevelError Level = 8
+)
+
+func (l Level) String() string {
+	switch {
+	case l < LevelInfo:
+		return "DEBUG"
+	case l < LevelWarn:
+		return "INFO"
+	case l < LevelError:
+		return "WARN"
+	default:
+		return "ERROR"
+	}
+}
+
+// Logger logs messages with alternating keys and values, like log/slog. *slog.Logger satisfies it.
+//
+// IPC calls, apply requests and VM API calls of native applies are logged at debug level.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+type discardLogger struct{}
+
+func (discardLogger) Debug(msg string, args ...interface{}) {}
+func (discardLogger) Info(msg string, args ...interface{})  {}
+func (discardLogger) Warn(msg string, args ...interface{})  {}
+func (discardLogger) Error(msg string, args ...interface{}) {}
+
+// TextLogger writes messages at or above a level as lines of key=value pairs,
+// in the format of slog.TextHandler
+type TextLogger struct {
+	mu    sync.Mutex
+	w     io.Writer
+	level Level
+}
+
+func NewTextLogger(w io.Writer, level Level) *TextLogger {
+	return &TextLogger{w: w, level: level}
+}
+
+func (l *TextLogger) Debug(msg string, args ...interface{}) { l.log(LevelDebug, msg, args) }
+func (l *TextLogger) Info(msg string, args ...interface{})  { l.log(LevelInfo, msg, args) }
+func (l *TextLogger) Warn(msg string, args ...interface{})  { l.log(LevelWarn, msg, args) }
+func (l *TextLogger) Error(msg string, args ...interface{}) { l.log(LevelError, msg, args) }
+
+func (l *TextLogger) Enabled(level Level) bool {
+	return level >= l.level
+}
+
+func formatLogValue(value interface{}) string {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case error:
+		s = v.Error()
+	case time.Duration:
+		s = v.String()
+	case time.Time:
+		s = v.Format(time.RFC3339Nano)
+	default:
+		s = fmt.Sprint(v)
+	}
+	if s == "" || strings.ContainsAny(s, " =\"\t\r\n") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+func (l *TextLogger) log(level Level, msg string, args []interface{}) {
+	if !l.Enabled(level) {
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString("time=")
+	b.WriteString(time.Now().Format(time.RFC3339Nano))
+	b.WriteString(" level=")
+	b.WriteString(level.String())
+	b.WriteString(" msg=")
+	b.WriteString(formatLogValue(msg))
+	for i := 0; i < len(args); i += 2 {
+		key, ok := args[i].(string)
+		if !ok || i+1 == len(args) {
+			// like slog, a value without a key is logged with the key !BADKEY
+			fmt.Fprintf(&b, " !BADKEY=%s", formatLogValue(args[i]))
+			i--
+			continue
+		}
+		fmt.Fprintf(&b, " %s=%s", key, formatLogValue(args[i+1]))
+	}
+	b.WriteByte('\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	io.WriteString(l.w, b.String())
+}
+
+var g_Logger Logger = NewTextLogger(os.Stderr, LevelInfo)
+
+// SetLogger sets the logger of the package, a nil logger discards every message
+func SetLogger(logger Logger) {
+	if logger == nil {
+		logger = discardLogger{}
+	}
+	g_Logger = logger
+}
+
+func GetLogger() Logger {
+	return g_Logger
+}
@@ -0,0 +1,63 @@
+package chaintester
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestTextLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewTextLogger(&buf, LevelInfo)
+
+	logger.Debug("hidden")
+	logger.Info("ipc call", "method", "push_action", "chain_id", 1)
+	logger.Warn("quoted", "error", errors.New(`bad "value"`), "empty", "")
+	logger.Error("bad keys", 1, "key")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("%d lines logged, expected 3:\n%s", len(lines), buf.String())
+	}
+	for i, expected := range []string{
+		` level=INFO msg="ipc call" method=push_action chain_id=1`,
+		` level=WARN msg=quoted error="bad \"value\"" empty=""`,
+		` level=ERROR msg="bad keys" !BADKEY=1 !BADKEY=key`,
+	} {
+		if !strings.HasPrefix(lines[i], "time=") || !strings.HasSuffix(lines[i], expected) {
+			t.Errorf("line %q does not end with %q", lines[i], expected)
+		}
+	}
+
+	for level, expected := range map[Level]string{LevelDebug: "DEBUG", LevelInfo: "INFO", LevelWarn: "WARN", LevelError: "ERROR", LevelError + 4: "ERROR"} {
+		if level.String() != expected {
+			t.Errorf("%d is %s, expected %s", level, level, expected)
+		}
+	}
+}
+
+func TestSetLogger(t *testing.T) {
+	logger := GetLogger()
+	defer SetLogger(logger)
+
+	SetLogger(nil)
+	GetLogger().Error("discarded")
+
+	var buf bytes.Buffer
+	SetLogger(NewTextLogger(&buf, LevelDebug))
+
+	// a server without a logger logs errors with the package logger
+	server := &SimpleIPCServer{}
+	server.logError(errors.New("broken pipe"))
+	if !strings.Contains(buf.String(), `level=ERROR msg="error processing request" error="broken pipe"`) {
+		t.Errorf("logged %q", buf.String())
+	}
+
+	var messages []string
+	server.SetLogger(func(msg string) { messages = append(messages, msg) })
+	server.logError(errors.New("broken pipe"))
+	if len(messages) != 1 || messages[0] != "error processing request: broken pipe" {
+		t.Errorf("logged %q", messages)
+	}
+}
@@ -0,0 +1,251 @@
+package chaintester
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/uuosio/chaintester/interfaces"
+)
+
+// CallRecord is a call to the IPCChainTester or Apply service, or an apply request
+// of the chain served by the ApplyRequest service, as written by Recorder
+type CallRecord struct {
+	// Seq is the order in which the calls were made, calls are written when they return,
+	// so a call made while another call is served is written before it
+	Seq     int64           `json:"seq"`
+	Service string          `json:"service"`
+	Method  string          `json:"method"`
+	Args    json.RawMessage `json:"args,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+// Recorder writes every call made to the chain tester server to a JSONL file, one CallRecord per line
+type Recorder struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+	seq    int64
+	err    error
+}
+
+var g_Recorder *Recorder
+
+// NewRecorder returns a recorder which writes to w
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: w}
+}
+
+// CreateRecorder returns a recorder which writes to file, the file is closed by Close
+func CreateRecorder(file string) (*Recorder, error) {
+	f, err := os.Create(file)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{w: f, closer: f}, nil
+}
+
+// SetRecorder records the calls made from now on with r, a nil r stops recording
+func SetRecorder(r *Recorder) {
+	g_Recorder = r
+}
+
+func GetRecorder() *Recorder {
+	return g_Recorder
+}
+
+// Err returns the first error which occurred while writing a record
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// Close closes the file of a recorder created by CreateRecorder and returns the first write error
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closer != nil {
+		if err := r.closer.Close(); err != nil && r.err == nil {
+			r.err = err
+		}
+		r.closer = nil
+	}
+	return r.err
+}
+
+func marshalRecord(v interface{}) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// begin starts the record of a call, it returns nil if r is nil
+func (r *Recorder) begin(service string, method string, args interface{}) *CallRecord {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seq++
+	return &CallRecord{
+		Seq:     r.seq,
+		Service: service,
+		Method:  method,
+		Args:    marshalRecord(args),
+	}
+}
+
+// end writes the record of a call which returned result and err
+func (r *Recorder) end(record *CallRecord, result interface{}, err error) {
+	if r == nil || record == nil {
+		return
+	}
+
+	if result != nil {
+		record.Result = marshalRecord(result)
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	line, _ := json.Marshal(record)
+	line = append(line, '\n')
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := r.w.Write(line); err != nil && r.err == nil {
+		r.err = err
+	}
+}
+
+// Replayer serves the calls of a recording in place of the chain tester server, so a recorded
+// test can be run again without the server. Calls must be made in the recorded order with the
+// recorded arguments, apply requests of the recording are served while the calls which made
+// them are replayed.
+type Replayer struct {
+	records []*CallRecord
+	next    int
+}
+
+var g_Replayer *Replayer
+
+// NewReplayer reads a recording written by Recorder
+func NewReplayer(r io.Reader) (*Replayer, error) {
+	var records []*CallRecord
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		record := &CallRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, newErrorf("recording line %d: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Seq < records[j].Seq
+	})
+	return &Replayer{records: records}, nil
+}
+
+// LoadReplayer reads a recording from file
+func LoadReplayer(file string) (*Replayer, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return NewReplayer(f)
+}
+
+// SetReplayer serves every call from the replayer p instead of the server. It must be called before
+// the first ChainTester is created, since the connections to the server are shared.
+func SetReplayer(p *Replayer) error {
+	if g_IPCClient != nil || g_VMAPI != nil {
+		return newErrorf("replayer must be set before connecting to the server")
+	}
+	g_Replayer = p
+	return nil
+}
+
+// Remaining returns the number of recorded calls which have not been replayed
+func (p *Replayer) Remaining() int {
+	return len(p.records) - p.next
+}
+
+func compactJSON(data []byte) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return string(data)
+	}
+	return buf.String()
+}
+
+// take returns the next record, which must be a call of method with args
+func (p *Replayer) take(service string, method string, args interface{}) (*CallRecord, error) {
+	if p.next >= len(p.records) {
+		return nil, newErrorf("replay: unexpected call %s.%s after the end of the recording", service, method)
+	}
+
+	record := p.records[p.next]
+	if record.Service != service || record.Method != method {
+		return nil, newErrorf("replay: call %s.%s does not match call %d %s.%s of the recording", service, method, record.Seq, record.Service, record.Method)
+	}
+	got, recorded := string(marshalRecord(args)), compactJSON(record.Args)
+	if got != recorded {
+		return nil, newErrorf("replay: arguments of call %d %s.%s do not match the recording\n  got:      %s\n  recorded: %s", record.Seq, service, method, got, recorded)
+	}
+	p.next++
+	return record, nil
+}
+
+// replay serves a call from the recording, beforeRecv is called before the result is returned
+func (p *Replayer) replay(service string, method string, args interface{}, result interface{}, beforeRecv func()) error {
+	record, err := p.take(service, method, args)
+	if err != nil {
+		return err
+	}
+
+	if beforeRecv != nil {
+		beforeRecv()
+	}
+
+	if result != nil && len(record.Result) != 0 {
+		if err := json.Unmarshal(record.Result, result); err != nil {
+			return newErrorf("replay: result of call %d %s.%s: %v", record.Seq, service, method, err)
+		}
+	}
+	if record.Error != "" {
+		return newErrorf("%s", record.Error)
+	}
+	return nil
+}
+
+// serveApplyRequests serves the recorded apply requests which follow the current call
+func (p *Replayer) serveApplyRequests() {
+	handler := NewApplyRequestHandler()
+	for p.next < len(p.records) && p.records[p.next].Service == "ApplyRequest" {
+		record := p.records[p.next]
+		p.next++
+
+		args := &interfaces.ApplyRequestApplyRequestArgs{}
+		if err := json.Unmarshal(record.Args, args); err != nil {
+			panic(newErrorf("replay: arguments of call %d %s.%s: %v", record.Seq, record.Service, record.Method, err))
+		}
+		handler.ApplyRequest(defaultCtx, args.Receiver, args.FirstReceiver, args.Action, args.ChainTesterId)
+	}
+}
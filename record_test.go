@@ -0,0 +1,171 @@
+package chaintester
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/uuosio/chaintester/interfaces"
+)
+
+func newTestRecord(seq int64, service string, method string, args interface{}, result interface{}) *CallRecord {
+	record := &CallRecord{Seq: seq, Service: service, Method: method, Args: marshalRecord(args)}
+	if result != nil {
+		record.Result = marshalRecord(result)
+	}
+	return record
+}
+
+func writeTestRecording(t *testing.T, records []*CallRecord) *bytes.Buffer {
+	var buf bytes.Buffer
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return &buf
+}
+
+// useReplayer serves the calls of the test from p and records them with r
+func useReplayer(t *testing.T, p *Replayer, r *Recorder) {
+	ipcClient, vmAPI, replayer, recorder := g_IPCClient, g_VMAPI, g_Replayer, g_Recorder
+	g_IPCClient, g_VMAPI = nil, nil
+	t.Cleanup(func() {
+		g_IPCClient, g_VMAPI, g_Replayer, g_Recorder = ipcClient, vmAPI, replayer, recorder
+	})
+
+	if err := SetReplayer(p); err != nil {
+		t.Fatal(err)
+	}
+	SetRecorder(r)
+}
+
+func TestReplay(t *testing.T) {
+	chainID := int32(1)
+	applyResult := int32(-1)
+	endApplyResult := int32(1)
+	freeChainResult := int32(0)
+	jsonArgs := `{"name": "go"}`
+	trace := []byte(`{"action_traces": [{"receiver": "hello", "act": {"account": "hello", "name": "inc"}, "console": "hi"}]}`)
+
+	records := []*CallRecord{
+		newTestRecord(1, "IPCChainTester", "init_vm_api", &interfaces.IPCChainTesterInitVMAPIArgs{}, nil),
+		newTestRecord(2, "IPCChainTester", "init_apply_request", &interfaces.IPCChainTesterInitApplyRequestArgs{}, nil),
+		newTestRecord(3, "IPCChainTester", "new_chain", &interfaces.IPCChainTesterNewChainArgs_{Initialize: true}, &interfaces.IPCChainTesterNewChainResult_{Success: &chainID}),
+		newTestRecord(4, "IPCChainTester", "enable_debug_contract", &interfaces.IPCChainTesterEnableDebugContractArgs{ID: chainID, Contract: "hello", Enable: true}, &interfaces.IPCChainTesterEnableDebugContractResult{}),
+		// apply requests made while push_action is served are written before it
+		newTestRecord(7, "Apply", "prints", &interfaces.ApplyPrintsArgs{Cstr: "hi"}, &interfaces.ApplyPrintsResult{}),
+		newTestRecord(8, "Apply", "end_apply", &interfaces.ApplyEndApplyArgs{}, &interfaces.ApplyEndApplyResult{Success: &endApplyResult}),
+		newTestRecord(6, "ApplyRequest", "apply_request", &interfaces.ApplyRequestApplyRequestArgs{
+			Receiver:      newUint64(S2N("hello")),
+			FirstReceiver: newUint64(S2N("hello")),
+			Action:        newUint64(S2N("inc")),
+			ChainTesterId: chainID,
+		}, &interfaces.ApplyRequestApplyRequestResult{Success: &applyResult}),
+		newTestRecord(5, "IPCChainTester", "push_action", &interfaces.IPCChainTesterPushActionArgs{
+			ID:          chainID,
+			Account:     "hello",
+			Action:      "inc",
+			Arguments:   &interfaces.ActionArguments{JSONArgs_: &jsonArgs},
+			Permissions: `{"hello": "active"}`,
+		}, &interfaces.IPCChainTesterPushActionResult{Success: trace}),
+		newTestRecord(9, "IPCChainTester", "free_chain", &interfaces.IPCChainTesterFreeChainArgs{ID: chainID}, &interfaces.IPCChainTesterFreeChainResult{Success: &freeChainResult}),
+	}
+
+	replayer, err := NewReplayer(writeTestRecording(t, records))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var recording bytes.Buffer
+	useReplayer(t, replayer, NewRecorder(&recording))
+
+	tester := NewChainTester()
+	if tester.ID() != chainID {
+		t.Fatalf("chain id %d, expected %d", tester.ID(), chainID)
+	}
+
+	applied := false
+	tester.SetNativeApply("hello", func(receiver uint64, firstReceiver uint64, action uint64) {
+		applied = N2S(receiver) == "hello" && N2S(action) == "inc"
+		GetVMAPI().Prints(defaultCtx, "hi")
+	})
+	value, err := tester.PushAction("hello", "inc", jsonArgs, `{"hello": "active"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !applied {
+		t.Error("native apply of the recorded apply request was not called")
+	}
+	if console := GetConsole(value); len(console) != 1 || console[0].Console != "hi" {
+		t.Errorf("console %v", console)
+	}
+	if _, err := tester.FreeChain(); err != nil {
+		t.Fatal(err)
+	}
+	if n := replayer.Remaining(); n != 0 {
+		t.Errorf("%d calls not replayed", n)
+	}
+
+	// the replayed calls are recorded like the calls to the server
+	rerecorded, err := NewReplayer(&recording)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rerecorded.records) != len(replayer.records) {
+		t.Fatalf("%d calls recorded, expected %d", len(rerecorded.records), len(replayer.records))
+	}
+	for i, record := range rerecorded.records {
+		expected := replayer.records[i]
+		if record.Seq != expected.Seq || record.Service != expected.Service || record.Method != expected.Method ||
+			compactJSON(record.Args) != compactJSON(expected.Args) || compactJSON(record.Result) != compactJSON(expected.Result) {
+			t.Errorf("recorded %+v, expected %+v", record, expected)
+		}
+	}
+}
+
+func TestReplayMismatch(t *testing.T) {
+	chainID := int32(1)
+	records := []*CallRecord{
+		newTestRecord(1, "IPCChainTester", "get_info", &interfaces.IPCChainTesterGetInfoArgs{ID: chainID}, nil),
+		{Seq: 2, Service: "IPCChainTester", Method: "get_info", Args: marshalRecord(&interfaces.IPCChainTesterGetInfoArgs{ID: chainID}), Error: "chain not found"},
+	}
+	replayer, err := NewReplayer(writeTestRecording(t, records))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewIPCClient(nil, nil)
+	client.service = "IPCChainTester"
+	client.replayer = replayer
+	tester := interfaces.NewIPCChainTesterClient(client)
+
+	for _, test := range []struct {
+		call func() error
+		err  string
+	}{
+		{func() error { _, err := tester.FreeChain(defaultCtx, chainID); return err }, "does not match call 1 IPCChainTester.get_info"},
+		{func() error { _, err := tester.GetInfo(defaultCtx, 2); return err }, "arguments of call 1 IPCChainTester.get_info do not match"},
+		{func() error { _, err := tester.GetInfo(defaultCtx, chainID); return err }, ""},
+		{func() error { _, err := tester.GetInfo(defaultCtx, chainID); return err }, "chain not found"},
+		{func() error { _, err := tester.GetInfo(defaultCtx, chainID); return err }, "after the end of the recording"},
+	} {
+		err := test.call()
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("error %v, expected %q", err, test.err)
+		}
+	}
+
+	if _, err := NewReplayer(strings.NewReader("{}\nnot json\n")); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("error %v, expected an error on line 2", err)
+	}
+}
@@ -0,0 +1,369 @@
+package chaintester
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// ActionUsage is the resource usage of the actions of a name executed by a contract, notifications
+// are counted for the contract which receives them
+type ActionUsage struct {
+	Contract string
+	Action   string
+	Calls    int
+	// elapsed time of the actions
+	CPU    time.Duration
+	MaxCPU time.Duration
+	// net usage of the transactions, divided among their top level actions
+	NetBytes    int64
+	MaxNetBytes int64
+	// sum of the RAM deltas of the accounts charged by the actions
+	RAMDelta    int64
+	MaxRAMDelta int64
+}
+
+// Name returns the name of the action like contract::action
+func (u *ActionUsage) Name() string {
+	return u.Contract + "::" + u.Action
+}
+
+type actionUsageJSON struct {
+	Contract    string `json:"contract"`
+	Action      string `json:"action"`
+	Calls       int    `json:"calls"`
+	CPU         int64  `json:"cpu_us"`
+	MaxCPU      int64  `json:"max_cpu_us"`
+	NetBytes    int64  `json:"net_bytes"`
+	MaxNetBytes int64  `json:"max_net_bytes"`
+	RAMDelta    int64  `json:"ram_delta"`
+	MaxRAMDelta int64  `json:"max_ram_delta"`
+}
+
+func (u ActionUsage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(actionUsageJSON{
+		u.Contract, u.Action, u.Calls,
+		u.CPU.Microseconds(), u.MaxCPU.Microseconds(),
+		u.NetBytes, u.MaxNetBytes,
+		u.RAMDelta, u.MaxRAMDelta,
+	})
+}
+
+func (u *ActionUsage) UnmarshalJSON(data []byte) error {
+	var v actionUsageJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*u = ActionUsage{
+		v.Contract, v.Action, v.Calls,
+		time.Duration(v.CPU) * time.Microsecond, time.Duration(v.MaxCPU) * time.Microsecond,
+		v.NetBytes, v.MaxNetBytes,
+		v.RAMDelta, v.MaxRAMDelta,
+	}
+	return nil
+}
+
+// ResourceReport is the resource usage of the transactions pushed to a chain
+type ResourceReport struct {
+	Transactions int `json:"transactions"`
+	// CPU billed to the transactions
+	CPU      time.Duration `json:"-"`
+	NetBytes int64         `json:"net_bytes"`
+	// usage by action, sorted by contract and action
+	Actions []ActionUsage `json:"actions"`
+}
+
+func (r *ResourceReport) MarshalJSON() ([]byte, error) {
+	type report ResourceReport
+	return json.Marshal(struct {
+		*report
+		CPU int64 `json:"cpu_us"`
+	}{(*report)(r), r.CPU.Microseconds()})
+}
+
+// Action returns the usage of an action, named like contract::action or by the action name only
+// if no other contract has an action of the name
+func (r *ResourceReport) Action(name string) (*ActionUsage, error) {
+	var found *ActionUsage
+	for i := range r.Actions {
+		usage := &r.Actions[i]
+		if usage.Name() != name && usage.Action != name {
+			continue
+		}
+		if usage.Name() == name {
+			return usage, nil
+		}
+		if found != nil {
+			return nil, newErrorf("action %s is ambiguous, it is executed by %s and %s", name, found.Contract, usage.Contract)
+		}
+		found = usage
+	}
+	if found == nil {
+		return nil, newErrorf("action %s has not been executed", name)
+	}
+	return found, nil
+}
+
+// WriteTable writes the usage of the actions as a table
+func (r *ResourceReport) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "action\tcalls\tcpu\tmax cpu\tnet\tmax net\tram\tmax ram\t")
+	for _, u := range r.Actions {
+		fmt.Fprintf(tw, "%s\t%d\t%v\t%v\t%d\t%d\t%d\t%d\t\n", u.Name(), u.Calls, u.CPU, u.MaxCPU, u.NetBytes, u.MaxNetBytes, u.RAMDelta, u.MaxRAMDelta)
+	}
+	fmt.Fprintf(tw, "%d transactions\t\t%v\t\t%d\t\t\t\t\n", r.Transactions, r.CPU, r.NetBytes)
+	return tw.Flush()
+}
+
+// String returns the table of the report
+func (r *ResourceReport) String() string {
+	var b strings.Builder
+	r.WriteTable(&b)
+	return b.String()
+}
+
+// resourceUsage collects the usage of the transactions pushed to a chain
+type resourceUsage struct {
+	transactions int
+	cpu          time.Duration
+	netBytes     int64
+	actions      map[string]*ActionUsage
+}
+
+type resourceTrace struct {
+	NetUsage int64 `json:"net_usage"`
+	Receipt  *struct {
+		CPUUsage int64 `json:"cpu_usage_us"`
+	} `json:"receipt"`
+	ActionTraces []struct {
+		CreatorActionOrdinal int64  `json:"creator_action_ordinal"`
+		Receiver             string `json:"receiver"`
+		Act                  struct {
+			Account string `json:"account"`
+			Name    string `json:"name"`
+		} `json:"act"`
+		Elapsed          int64 `json:"elapsed"`
+		AccountRAMDeltas []struct {
+			Delta int64 `json:"delta"`
+		} `json:"account_ram_deltas"`
+	} `json:"action_traces"`
+}
+
+func (u *resourceUsage) collect(trace []byte) {
+	var trx resourceTrace
+	if err := json.Unmarshal(trace, &trx); err != nil {
+		return
+	}
+
+	u.transactions++
+	u.netBytes += trx.NetUsage
+	if trx.Receipt != nil {
+		u.cpu += time.Duration(trx.Receipt.CPUUsage) * time.Microsecond
+	}
+
+	topLevel := int64(0)
+	for _, act := range trx.ActionTraces {
+		if act.CreatorActionOrdinal == 0 && act.Receiver == act.Act.Account {
+			topLevel++
+		}
+	}
+
+	if u.actions == nil {
+		u.actions = make(map[string]*ActionUsage)
+	}
+	for _, act := range trx.ActionTraces {
+		key := act.Receiver + "::" + act.Act.Name
+		usage, ok := u.actions[key]
+		if !ok {
+			usage = &ActionUsage{Contract: act.Receiver, Action: act.Act.Name}
+			u.actions[key] = usage
+		}
+
+		cpu := time.Duration(act.Elapsed) * time.Microsecond
+		var net, ram int64
+		if act.CreatorActionOrdinal == 0 && act.Receiver == act.Act.Account {
+			net = trx.NetUsage / topLevel
+		}
+		for _, delta := range act.AccountRAMDeltas {
+			ram += delta.Delta
+		}
+
+		usage.Calls++
+		usage.CPU += cpu
+		usage.NetBytes += net
+		usage.RAMDelta += ram
+		if cpu > usage.MaxCPU {
+			usage.MaxCPU = cpu
+		}
+		if net > usage.MaxNetBytes {
+			usage.MaxNetBytes = net
+		}
+		if ram > usage.MaxRAMDelta {
+			usage.MaxRAMDelta = ram
+		}
+	}
+}
+
+func (u *resourceUsage) report() *ResourceReport {
+	r := &ResourceReport{Transactions: u.transactions, CPU: u.cpu, NetBytes: u.netBytes, Actions: []ActionUsage{}}
+	for _, usage := range u.actions {
+		r.Actions = append(r.Actions, *usage)
+	}
+	sort.Slice(r.Actions, func(i, j int) bool {
+		return r.Actions[i].Name() < r.Actions[j].Name()
+	})
+	return r
+}
+
+// Resources returns the resource usage of the transactions pushed successfully since the chain
+// was created or ResetResources was called
+func (p *ChainTester) Resources() *ResourceReport {
+	return p.resources.report()
+}
+
+func (p *ChainTester) ResetResources() {
+	p.resources = resourceUsage{}
+}
+
+// ProfileT collects the resource usage of the test t, which is logged as a table when the test completes
+func (p *ChainTester) ProfileT(t TestingT) {
+	t.Helper()
+	p.ResetResources()
+	t.Cleanup(func() {
+		if logger, ok := t.(ConsoleLogger); ok {
+			logger.Log("resource usage:\n" + p.Resources().String())
+		}
+	})
+}
+
+func (p *ChainTester) actionUsage(t TestingT, action string) *ActionUsage {
+	t.Helper()
+	usage, err := p.Resources().Action(action)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	return usage
+}
+
+// RequireCPUUnder fails the test if an action, named like contract::action or action, took limit or longer
+func (p *ChainTester) RequireCPUUnder(t TestingT, action string, limit time.Duration) {
+	t.Helper()
+	if usage := p.actionUsage(t, action); usage.MaxCPU >= limit {
+		t.Fatalf("%s used %v of CPU, expected less than %v", usage.Name(), usage.MaxCPU, limit)
+	}
+}
+
+// RequireNetUnder fails the test if a transaction of an action used limit bytes of net or more
+func (p *ChainTester) RequireNetUnder(t TestingT, action string, limit int64) {
+	t.Helper()
+	if usage := p.actionUsage(t, action); usage.MaxNetBytes >= limit {
+		t.Fatalf("%s used %d bytes of net, expected less than %d", usage.Name(), usage.MaxNetBytes, limit)
+	}
+}
+
+// RequireRAMUnder fails the test if an action increased the RAM usage by limit bytes or more
+func (p *ChainTester) RequireRAMUnder(t TestingT, action string, limit int64) {
+	t.Helper()
+	if usage := p.actionUsage(t, action); usage.MaxRAMDelta >= limit {
+		t.Fatalf("%s used %d bytes of RAM, expected less than %d", usage.Name(), usage.MaxRAMDelta, limit)
+	}
+}
+
+// LoadBaseline reads the usage of actions written by WriteBaseline
+func LoadBaseline(file string) (map[string]ActionUsage, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var baseline map[string]ActionUsage
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return nil, newErrorf("baseline %s: %v", file, err)
+	}
+	return baseline, nil
+}
+
+// WriteBaseline writes the usage of the actions in the report to file, keeping the usage of
+// other actions in the file, so tests can share a baseline
+func (r *ResourceReport) WriteBaseline(file string) error {
+	baseline, err := LoadBaseline(file)
+	if os.IsNotExist(err) {
+		baseline = map[string]ActionUsage{}
+	} else if err != nil {
+		return err
+	}
+	for _, usage := range r.Actions {
+		baseline[usage.Name()] = usage
+	}
+
+	data, err := json.MarshalIndent(baseline, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, append(data, '\n'), 0644)
+}
+
+func exceeds(value int64, baseline int64, tolerance float64) bool {
+	return float64(value) > float64(baseline)*(1+tolerance)
+}
+
+// CompareBaseline returns a message for every action whose maximal usage of CPU, net or RAM
+// exceeds its usage in the baseline by more than tolerance, 0.1 for 10%
+func (r *ResourceReport) CompareBaseline(baseline map[string]ActionUsage, tolerance float64) []string {
+	var regressions []string
+	for _, usage := range r.Actions {
+		base, ok := baseline[usage.Name()]
+		if !ok {
+			continue
+		}
+		if exceeds(int64(usage.MaxCPU), int64(base.MaxCPU), tolerance) {
+			regressions = append(regressions, fmt.Sprintf("%s: cpu %v, baseline %v", usage.Name(), usage.MaxCPU, base.MaxCPU))
+		}
+		if exceeds(usage.MaxNetBytes, base.MaxNetBytes, tolerance) {
+			regressions = append(regressions, fmt.Sprintf("%s: net %d bytes, baseline %d bytes", usage.Name(), usage.MaxNetBytes, base.MaxNetBytes))
+		}
+		if exceeds(usage.MaxRAMDelta, base.MaxRAMDelta, tolerance) {
+			regressions = append(regressions, fmt.Sprintf("%s: ram %d bytes, baseline %d bytes", usage.Name(), usage.MaxRAMDelta, base.MaxRAMDelta))
+		}
+	}
+	return regressions
+}
+
+// RequireBaselineT compares the resource usage of the test t with the baseline file when the test
+// completes and fails it on regressions. Actions which are not in the file are added to it, so the
+// first run creates the baseline, and removing the file updates it.
+func (p *ChainTester) RequireBaselineT(t TestingT, file string, tolerance float64) {
+	t.Helper()
+	p.ResetResources()
+	t.Cleanup(func() {
+		report := p.Resources()
+		baseline, err := LoadBaseline(file)
+		if os.IsNotExist(err) {
+			baseline = map[string]ActionUsage{}
+		} else if err != nil {
+			t.Errorf("%v", err)
+			return
+		}
+
+		for _, regression := range report.CompareBaseline(baseline, tolerance) {
+			t.Errorf("resource usage regression of %s", regression)
+		}
+
+		added := &ResourceReport{}
+		for _, usage := range report.Actions {
+			if _, ok := baseline[usage.Name()]; !ok {
+				added.Actions = append(added.Actions, usage)
+			}
+		}
+		if len(added.Actions) == 0 {
+			return
+		}
+		if err := added.WriteBaseline(file); err != nil {
+			t.Errorf("write baseline: %v", err)
+		}
+	})
+}
@@ -0,0 +1,196 @@
+package chaintester
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeT records the failures and logs of a test
+type fakeT struct {
+	errors   []string
+	logs     []string
+	cleanups []func()
+}
+
+type fakeTFatal struct{}
+
+func (t *fakeT) Helper()                 {}
+func (t *fakeT) Cleanup(f func())        { t.cleanups = append(t.cleanups, f) }
+func (t *fakeT) Log(args ...interface{}) { t.logs = append(t.logs, fmt.Sprint(args...)) }
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Fatalf(format string, args ...interface{}) {
+	t.Errorf(format, args...)
+	panic(fakeTFatal{})
+}
+
+// run runs f like a test and then the cleanups
+func (t *fakeT) run(f func()) {
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(fakeTFatal); !ok {
+					panic(r)
+				}
+			}
+		}()
+		f()
+	}()
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+const resourceTestTrace = `{
+	"net_usage": 128,
+	"receipt": {"status": "executed", "cpu_usage_us": 300, "net_usage_words": 16},
+	"action_traces": [
+		{"action_ordinal": 1, "creator_action_ordinal": 0, "receiver": "eosio.token", "act": {"account": "eosio.token", "name": "transfer"}, "elapsed": 120, "account_ram_deltas": []},
+		{"action_ordinal": 2, "creator_action_ordinal": 1, "receiver": "alice", "act": {"account": "eosio.token", "name": "transfer"}, "elapsed": 30, "account_ram_deltas": [{"account": "alice", "delta": 112}]},
+		{"action_ordinal": 3, "creator_action_ordinal": 0, "receiver": "hello", "act": {"account": "hello", "name": "inc"}, "elapsed": 80, "account_ram_deltas": [{"account": "hello", "delta": 240}, {"account": "bob", "delta": -10}]}
+	]
+}`
+
+func newResourceTester() *ChainTester {
+	tester := &ChainTester{}
+	tester.resources.collect([]byte(resourceTestTrace))
+	tester.resources.collect([]byte(strings.Replace(resourceTestTrace, `"elapsed": 80`, `"elapsed": 400`, 1)))
+	return tester
+}
+
+func TestResourceReport(t *testing.T) {
+	report := newResourceTester().Resources()
+	if report.Transactions != 2 || report.CPU != 600*time.Microsecond || report.NetBytes != 256 {
+		t.Errorf("report %+v", report)
+	}
+
+	expected := []ActionUsage{
+		{"alice", "transfer", 2, 60 * time.Microsecond, 30 * time.Microsecond, 0, 0, 224, 112},
+		{"eosio.token", "transfer", 2, 240 * time.Microsecond, 120 * time.Microsecond, 128, 64, 0, 0},
+		{"hello", "inc", 2, 480 * time.Microsecond, 400 * time.Microsecond, 128, 64, 460, 230},
+	}
+	if len(report.Actions) != len(expected) {
+		t.Fatalf("actions %+v", report.Actions)
+	}
+	for i, usage := range report.Actions {
+		if usage != expected[i] {
+			t.Errorf("usage %+v, expected %+v", usage, expected[i])
+		}
+	}
+
+	if usage, err := report.Action("inc"); err != nil || usage.Contract != "hello" {
+		t.Errorf("action inc: %v, %v", usage, err)
+	}
+	if usage, err := report.Action("alice::transfer"); err != nil || usage.Contract != "alice" {
+		t.Errorf("action alice::transfer: %v, %v", usage, err)
+	}
+	if _, err := report.Action("transfer"); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("action transfer: %v", err)
+	}
+	if _, err := report.Action("dec"); err == nil {
+		t.Error("action dec found")
+	}
+
+	if table := report.String(); !strings.Contains(table, "hello::inc") || !strings.Contains(table, "2 transactions") {
+		t.Errorf("table:\n%s", table)
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded struct {
+		CPU     int64         `json:"cpu_us"`
+		Actions []ActionUsage `json:"actions"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.CPU != 600 || len(decoded.Actions) != 3 || decoded.Actions[2] != expected[2] {
+		t.Errorf("json %s", data)
+	}
+}
+
+func TestRequireResources(t *testing.T) {
+	tester := newResourceTester()
+	for _, test := range []struct {
+		require func(t TestingT)
+		err     string
+	}{
+		{func(t TestingT) { tester.RequireCPUUnder(t, "inc", 500*time.Microsecond) }, ""},
+		{func(t TestingT) { tester.RequireCPUUnder(t, "hello::inc", 400*time.Microsecond) }, "hello::inc used 400µs of CPU, expected less than 400µs"},
+		{func(t TestingT) { tester.RequireNetUnder(t, "eosio.token::transfer", 65) }, ""},
+		{func(t TestingT) { tester.RequireNetUnder(t, "inc", 64) }, "used 64 bytes of net"},
+		{func(t TestingT) { tester.RequireRAMUnder(t, "alice::transfer", 100) }, "used 112 bytes of RAM"},
+		{func(t TestingT) { tester.RequireRAMUnder(t, "dec", 100) }, "action dec has not been executed"},
+	} {
+		ft := &fakeT{}
+		ft.run(func() { test.require(ft) })
+		if test.err == "" && len(ft.errors) != 0 || test.err != "" && (len(ft.errors) != 1 || !strings.Contains(ft.errors[0], test.err)) {
+			t.Errorf("errors %q, expected %q", ft.errors, test.err)
+		}
+	}
+
+	ft := &fakeT{}
+	ft.run(func() {
+		tester.ProfileT(ft)
+		tester.resources.collect([]byte(resourceTestTrace))
+	})
+	if len(ft.logs) != 1 || !strings.Contains(ft.logs[0], "1 transactions") {
+		t.Errorf("logs %q", ft.logs)
+	}
+}
+
+func TestBaseline(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "baseline.json")
+
+	tester := &ChainTester{}
+	ft := &fakeT{}
+	ft.run(func() {
+		tester.RequireBaselineT(ft, file, 0.1)
+		tester.resources.collect([]byte(resourceTestTrace))
+	})
+	if len(ft.errors) != 0 {
+		t.Fatalf("errors %q", ft.errors)
+	}
+	baseline, err := LoadBaseline(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(baseline) != 3 || baseline["hello::inc"].MaxCPU != 80*time.Microsecond {
+		t.Fatalf("baseline %+v", baseline)
+	}
+
+	// within the tolerance
+	ft = &fakeT{}
+	ft.run(func() {
+		tester.RequireBaselineT(ft, file, 0.1)
+		tester.resources.collect([]byte(strings.Replace(resourceTestTrace, `"elapsed": 80`, `"elapsed": 88`, 1)))
+	})
+	if len(ft.errors) != 0 {
+		t.Errorf("errors %q", ft.errors)
+	}
+
+	ft = &fakeT{}
+	ft.run(func() {
+		tester.RequireBaselineT(ft, file, 0.1)
+		trace := strings.Replace(resourceTestTrace, `"elapsed": 80`, `"elapsed": 89`, 1)
+		trace = strings.Replace(trace, `"delta": 112`, `"delta": 200`, 1)
+		tester.resources.collect([]byte(trace))
+	})
+	if len(ft.errors) != 2 || !strings.Contains(ft.errors[0], "alice::transfer: ram 200 bytes, baseline 112 bytes") || !strings.Contains(ft.errors[1], "hello::inc: cpu 89µs, baseline 80µs") {
+		t.Errorf("errors %q", ft.errors)
+	}
+
+	// the baseline is not updated by regressions
+	if baseline, err := LoadBaseline(file); err != nil || baseline["hello::inc"].MaxCPU != 80*time.Microsecond {
+		t.Errorf("baseline %+v, %v", baseline, err)
+	}
+}
@@ -16,6 +16,9 @@ import (
 var g_VMAPI *interfaces.ApplyClient
 var g_InApply = false
 
+// id of the chain which sent the apply request being served
+var g_ApplyChainTesterId int32
+
 func SetInApply(inApply bool) {
 	g_InApply = inApply
 }
@@ -30,8 +33,7 @@ func InitVMAPI() {
 	}
 
 	var err error
-	address := fmt.Sprintf("%s:%s", GetDebuggerConfig().VMAPIServerAddress, GetDebuggerConfig().VMAPIServerPort)
-	g_VMAPI, err = NewVMAPIClient(address)
+	g_VMAPI, err = connectVMAPI()
 	if err != nil {
 		panic(err)
 	}
@@ -46,8 +48,7 @@ func GetVMAPI() *interfaces.ApplyClient {
 	}
 
 	var err error
-	address := fmt.Sprintf("%s:%s", GetDebuggerConfig().VMAPIServerAddress, GetDebuggerConfig().VMAPIServerPort)
-	g_VMAPI, err = NewVMAPIClient(address)
+	g_VMAPI, err = connectVMAPI()
 	if err != nil {
 		panic(err)
 	}
@@ -80,8 +81,11 @@ func NewProtocol(addr string) (thrift.TProtocol, thrift.TProtocol, error) {
 	if err := g_VMAPITransport.Open(); err != nil {
 		return nil, nil, err
 	}
-	iprot := protocolFactory.GetProtocol(g_VMAPITransport)
-	oprot := protocolFactory.GetProtocol(g_VMAPITransport)
+	GetLogger().Debug("ipc connected", "addr", addr)
+	// count the bytes of each call for tracing
+	transport := &countingTransport{TTransport: g_VMAPITransport}
+	iprot := protocolFactory.GetProtocol(transport)
+	oprot := protocolFactory.GetProtocol(transport)
 	return iprot, oprot, nil
 }
 
@@ -92,7 +96,25 @@ func NewVMAPIClient(addr string) (*interfaces.ApplyClient, error) {
 	}
 	// transport.Close()
 	// oprot.Transport().Close()
-	return interfaces.NewApplyClient(NewIPCClient(iprot, oprot)), nil
+	return newApplyClient(NewIPCClient(iprot, oprot)), nil
+}
+
+func newApplyClient(client *IPCClient) *interfaces.ApplyClient {
+	client.service = "Apply"
+	client.beforeSend = onVMAPICall
+	return interfaces.NewApplyClient(client)
+}
+
+// connectVMAPI returns a client of the VM API server, which is served by the replayer if one is set
+func connectVMAPI() (*interfaces.ApplyClient, error) {
+	if g_Replayer != nil {
+		client := NewIPCClient(nil, nil)
+		client.replayer = g_Replayer
+		return newApplyClient(client), nil
+	}
+
+	address := fmt.Sprintf("%s:%s", GetDebuggerConfig().VMAPIServerAddress, GetDebuggerConfig().VMAPIServerPort)
+	return NewVMAPIClient(address)
 }
 
 type ApplyRequestHandler struct {
@@ -109,20 +131,45 @@ func getUint64(value *interfaces.Uint64) uint64 {
 	return binary.LittleEndian.Uint64(value.RawValue)
 }
 
+func newUint64(value uint64) *interfaces.Uint64 {
+	rawValue := make([]byte, 8)
+	binary.LittleEndian.PutUint64(rawValue, value)
+	return &interfaces.Uint64{RawValue: rawValue}
+}
+
 var g_ChainTesterApplyMap = make(map[int32]map[string]func(uint64, uint64, uint64))
 
 func (p *ApplyRequestHandler) ApplyRequest(ctx context.Context, receiver *interfaces.Uint64, firstReceiver *interfaces.Uint64, action *interfaces.Uint64, chainTesterId int32) (_r int32, _err error) {
-	// fmt.Println("+++++++ApplyRequest called!")
+	record := GetRecorder().begin("ApplyRequest", "apply_request", &interfaces.ApplyRequestApplyRequestArgs{
+		Receiver:      receiver,
+		FirstReceiver: firstReceiver,
+		Action:        action,
+		ChainTesterId: chainTesterId,
+	})
+	_, span := GetTracer().Start(ctx, "ApplyRequest.apply_request",
+		Attr(attrService, "ApplyRequest"),
+		Attr(attrMethod, "apply_request"),
+		Attr(attrChainID, chainTesterId),
+	)
+	defer func() {
+		GetRecorder().end(record, &interfaces.ApplyRequestApplyRequestResult{Success: &_r}, _err)
+		if _err != nil {
+			span.RecordError(_err)
+		}
+		span.End()
+	}()
+
 	defer func() {
 		if err := recover(); err != nil {
 			_, ok := err.(*AssertError)
 			if ok {
-				// fmt.Printf("recovered from assertion error: %v", _r)
+				GetLogger().Debug("native apply assertion failed", "chain_id", chainTesterId, "error", err)
 			} else {
-				// fmt.Printf("recovered error: %s", err)
+				GetLogger().Debug("native apply failed", "chain_id", chainTesterId, "error", err)
 				_err = fmt.Errorf("%v", err)
 				_r = -1
 			}
+			endNativeConsole()
 			GetVMAPI().EndApply(ctx)
 			SetInApply(false)
 		}
@@ -132,12 +179,16 @@ func (p *ApplyRequestHandler) ApplyRequest(ctx context.Context, receiver *interf
 	_firstReceiver := getUint64(firstReceiver)
 	_action := getUint64(action)
 
+	GetLogger().Debug("apply request", "chain_id", chainTesterId, "receiver", N2S(_receiver), "first_receiver", N2S(_firstReceiver), "action", N2S(_action))
+	g_ApplyChainTesterId = chainTesterId
 	SetInApply(true)
+	beginNativeConsole(chainTesterId, _receiver, _firstReceiver, _action)
 	if applyMap, ok := g_ChainTesterApplyMap[chainTesterId]; ok {
 		if apply, ok := applyMap[N2S(_receiver)]; ok {
 			apply(_receiver, _firstReceiver, _action)
 		}
 	}
+	endNativeConsole()
 	GetVMAPI().EndApply(ctx)
 	SetInApply(false)
 
@@ -147,7 +198,7 @@ func (p *ApplyRequestHandler) ApplyRequest(ctx context.Context, receiver *interf
 }
 
 func (p *ApplyRequestHandler) ApplyEnd(ctx context.Context, chainTesterId int32) (_r int32, _err error) {
-	// fmt.Println("+++++++ApplyEnd")
+	GetLogger().Debug("apply end", "chain_id", chainTesterId)
 	GetApplyRequestServer().server.EndProcessRequests()
 	return 1, nil
 }
@@ -189,11 +240,11 @@ func (server *ApplyRequestServer) AcceptOnce() (int32, error) {
 }
 
 func (server *ApplyRequestServer) Serve() (int32, error) {
-	// fmt.Println("+++++++ApplyRequestServer:ProcessRequests")
+	GetLogger().Debug("serving apply requests")
 	return server.server.ProcessRequests()
 }
 
 func (server *ApplyRequestServer) Stop() error {
-	fmt.Println("+++++++ApplyRequestServer:Stop")
+	GetLogger().Debug("stopping apply request server")
 	return server.server.Stop()
 }
@@ -0,0 +1,97 @@
+package chaintester
+
+import "testing"
+
+// TestingT is the subset of testing.TB used by ChainTester
+type TestingT interface {
+	Helper()
+	Cleanup(func())
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+}
+
+// Session is an undo session on the chain state, every change made after
+// Begin is discarded by Rollback. Blocks can not be produced while a session is open.
+type Session struct {
+	tester *ChainTester
+	id     int32
+	closed bool
+}
+
+// Begin opens an undo session, sessions can be nested and must be closed in reverse order
+func (p *ChainTester) Begin() (*Session, error) {
+	id, err := p.IPCChainTesterClient.BeginUndoSession(defaultCtx, p.id)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &Session{tester: p, id: id}
+	p.sessions = append(p.sessions, session)
+	return session, nil
+}
+
+// BeginT opens an undo session which is rolled back when the test and all its subtests complete.
+// Subtests started by t.Run are not isolated from each other unless each of them calls BeginT,
+// use Run to isolate them automatically.
+func (p *ChainTester) BeginT(t TestingT) *Session {
+	t.Helper()
+	session, err := p.Begin()
+	if err != nil {
+		t.Fatalf("begin undo session: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if session.closed {
+			return
+		}
+		if err := session.Rollback(); err != nil {
+			t.Errorf("rollback undo session: %v", err)
+		}
+	})
+	return session
+}
+
+// Run runs f as the subtest name of t in an undo session, which is rolled back when the subtest
+// completes, so every subtest starts from the same state. Subtests run by Run must not call t.Parallel,
+// undo sessions of a chain must be closed in reverse order.
+func (p *ChainTester) Run(t *testing.T, name string, f func(t *testing.T)) bool {
+	t.Helper()
+	return t.Run(name, func(t *testing.T) {
+		p.BeginT(t)
+		f(t)
+	})
+}
+
+func (s *Session) close() error {
+	if s.closed {
+		return newErrorf("undo session %d is closed", s.id)
+	}
+
+	sessions := s.tester.sessions
+	if len(sessions) == 0 || sessions[len(sessions)-1] != s {
+		return newErrorf("undo session %d is not the innermost session", s.id)
+	}
+	s.tester.sessions = sessions[:len(sessions)-1]
+	s.closed = true
+	return nil
+}
+
+// Rollback discards every change made since the session began
+func (s *Session) Rollback() error {
+	if err := s.close(); err != nil {
+		return err
+	}
+	return s.tester.IPCChainTesterClient.RollbackUndoSession(defaultCtx, s.tester.id, s.id)
+}
+
+// Commit keeps the changes made since the session began, merging them into the enclosing session if any
+func (s *Session) Commit() error {
+	if err := s.close(); err != nil {
+		return err
+	}
+	return s.tester.IPCChainTesterClient.CommitUndoSession(defaultCtx, s.tester.id, s.id)
+}
+
+func (p *ChainTester) InSession() bool {
+	return len(p.sessions) != 0
+}
@@ -162,12 +162,19 @@ func (p *SimpleIPCServer) SetForwardHeaders(headers []string) {
 
 // SetLogger sets the logger used by this SimpleIPCServer.
 //
-// If no logger was set before Serve is called, a default logger using standard
-// log library will be used.
+// If no logger is set, errors are logged by the logger of the package.
 func (p *SimpleIPCServer) SetLogger(logger thrift.Logger) {
 	p.logger = logger
 }
 
+func (p *SimpleIPCServer) logError(err error) {
+	if p.logger != nil {
+		p.logger(fmt.Sprintf("error processing request: %v", err))
+		return
+	}
+	GetLogger().Error("error processing request", "error", err)
+}
+
 func (p *SimpleIPCServer) innerAccept() (int32, error) {
 	client, err := p.serverTransport.Accept()
 	p.mu.Lock()
@@ -184,7 +191,7 @@ func (p *SimpleIPCServer) innerAccept() (int32, error) {
 		go func() {
 			defer p.wg.Done()
 			if err := p.processRequests(client); err != nil {
-				p.logger(fmt.Sprintf("error processing request: %v", err))
+				p.logError(err)
 			}
 		}()
 	}
@@ -203,7 +210,7 @@ func (p *SimpleIPCServer) AcceptOnce() (int32, error) {
 	if err != nil {
 		return 0, err
 	}
-	// fmt.Println("+++++++++SimpleIPCServer: new client connected")
+	GetLogger().Debug("ipc client connected")
 	p.client = client
 
 	p.inputTransport, err = p.inputTransportFactory.GetTransport(client)
@@ -242,7 +249,7 @@ func (p *SimpleIPCServer) AcceptOnce() (int32, error) {
 
 func (p *SimpleIPCServer) ProcessRequests() (int32, error) {
 	if err := p.processRequests(p.client); err != nil {
-		p.logger(fmt.Sprintf("error processing request: %v", err))
+		p.logError(err)
 	}
 	return 0, nil
 }
@@ -260,10 +267,6 @@ func (p *SimpleIPCServer) AcceptLoop() error {
 }
 
 func (p *SimpleIPCServer) Serve() error {
-	if p.logger == nil {
-		p.logger = thrift.StdLogger(nil)
-	}
-
 	err := p.Listen()
 	if err != nil {
 		return err
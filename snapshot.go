@@ -0,0 +1,54 @@
+package chaintester
+
+// SnapshotID identifies a saved state of a chain
+type SnapshotID int32
+
+// Snapshot saves the current state of the chain, the state can be restored later with Restore
+func (p *ChainTester) Snapshot() (SnapshotID, error) {
+	id, err := p.IPCChainTesterClient.Snapshot(defaultCtx, p.id)
+	if err != nil {
+		return 0, err
+	}
+	return SnapshotID(id), nil
+}
+
+// Restore reverts the chain to the state saved by Snapshot, the snapshot can be restored again
+func (p *ChainTester) Restore(id SnapshotID) error {
+	if p.InSession() {
+		return newErrorf("can not restore snapshot while an undo session is open")
+	}
+	return p.IPCChainTesterClient.Restore(defaultCtx, p.id, int32(id))
+}
+
+// FreeSnapshot releases the resources held by a snapshot
+func (p *ChainTester) FreeSnapshot(id SnapshotID) error {
+	return p.IPCChainTesterClient.FreeSnapshot(defaultCtx, p.id, int32(id))
+}
+
+// Fork creates a new chain with a copy of the current state of the chain, native apply
+// functions are shared with the new chain and the key store is copied to it.
+func (p *ChainTester) Fork() (*ChainTester, error) {
+	if p.InSession() {
+		return nil, newErrorf("can not fork chain while an undo session is open")
+	}
+	id, err := p.IPCChainTesterClient.Fork(defaultCtx, p.id)
+	if err != nil {
+		return nil, err
+	}
+
+	tester := &ChainTester{
+		IPCChainTesterClient: p.IPCChainTesterClient,
+		client:               p.client,
+		id:                   id,
+		consoleLogger:        p.consoleLogger,
+		timeFrozen:           p.timeFrozen,
+		keyStore:             p.keyStore.clone(),
+		coreSymbol:           p.coreSymbol,
+	}
+	g_ChainTesters[id] = tester
+
+	for contract, apply := range g_ChainTesterApplyMap[p.id] {
+		tester.SetNativeApply(contract, apply)
+	}
+	return tester, nil
+}
@@ -0,0 +1,16 @@
+package chaintester
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestForkInSession(t *testing.T) {
+	tester := &ChainTester{sessions: []*Session{{}}}
+	if _, err := tester.Fork(); err == nil || !strings.Contains(err.Error(), "undo session") {
+		t.Errorf("fork in session: %v", err)
+	}
+	if err := tester.Restore(1); err == nil || !strings.Contains(err.Error(), "undo session") {
+		t.Errorf("restore in session: %v", err)
+	}
+}
@@ -0,0 +1,281 @@
+package chaintester
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/uuosio/chaintester/interfaces"
+)
+
+// ResourceLimits are the resource limits of an account, -1 is unlimited
+type ResourceLimits struct {
+	RAMBytes  int64
+	NetWeight int64
+	CPUWeight int64
+}
+
+// GetResourceLimits returns the resource limits of account
+func (p *ChainTester) GetResourceLimits(account string) (*ResourceLimits, error) {
+	info, err := p.GetAccountInfo(account)
+	if err != nil {
+		return nil, err
+	}
+	return &ResourceLimits{int64(info.RAMQuota), int64(info.NetWeight), int64(info.CPUWeight)}, nil
+}
+
+// SetResourceLimits sets the resource limits of account with the set_resource_limits intrinsic, which is
+// called from a temporary native apply of the privileged eosio account. The limits are set in the resource
+// limits manager of the chain without the system contract, which does not know about the change.
+func (p *ChainTester) SetResourceLimits(account string, limits ResourceLimits) (*JsonValue, error) {
+	if err := checkName("account", account); err != nil {
+		return nil, err
+	}
+
+	ret, err := p.pushPrivileged("setreslimits", func() error {
+		return GetVMAPI().SetResourceLimits(defaultCtx, newUint64(S2N(account)), limits.RAMBytes, limits.NetWeight, limits.CPUWeight)
+	})
+	if err != nil {
+		return nil, newErrorf("set resource limits of %s: %v", account, err)
+	}
+	return ret, nil
+}
+
+// BuyRAM buys RAM for receiver with quant of the core token like "1.0000 EOS" paid by payer
+func (p *ChainTester) BuyRAM(payer string, receiver string, quant string) (*JsonValue, error) {
+	args := map[string]interface{}{
+		"payer":    payer,
+		"receiver": receiver,
+		"quant":    quant,
+	}
+	return p.pushAuthAction(newAction("eosio", "buyram", args, PermissionLevel{payer, "active"}))
+}
+
+// BuyRAMBytes buys bytes of RAM for receiver paid by payer
+func (p *ChainTester) BuyRAMBytes(payer string, receiver string, bytes int64) (*JsonValue, error) {
+	args := map[string]interface{}{
+		"payer":    payer,
+		"receiver": receiver,
+		"bytes":    bytes,
+	}
+	return p.pushAuthAction(newAction("eosio", "buyrambytes", args, PermissionLevel{payer, "active"}))
+}
+
+// SellRAM sells bytes of RAM of account
+func (p *ChainTester) SellRAM(account string, bytes int64) (*JsonValue, error) {
+	args := map[string]interface{}{
+		"account": account,
+		"bytes":   bytes,
+	}
+	return p.pushAuthAction(newAction("eosio", "sellram", args, PermissionLevel{account, "active"}))
+}
+
+// Stake delegates net and cpu bandwidth from from to receiver, net and cpu are amounts of the core token in units of its precision like the stakes of CreateAccount
+func (p *ChainTester) Stake(from string, receiver string, net int64, cpu int64) (*JsonValue, error) {
+	args := map[string]interface{}{
+		"from":               from,
+		"receiver":           receiver,
+		"stake_net_quantity": p.coreSymbol.format(net),
+		"stake_cpu_quantity": p.coreSymbol.format(cpu),
+		"transfer":           false,
+	}
+	return p.pushAuthAction(newAction("eosio", "delegatebw", args, PermissionLevel{from, "active"}))
+}
+
+// Unstake undelegates net and cpu bandwidth delegated by Stake
+func (p *ChainTester) Unstake(from string, receiver string, net int64, cpu int64) (*JsonValue, error) {
+	args := map[string]interface{}{
+		"from":                 from,
+		"receiver":             receiver,
+		"unstake_net_quantity": p.coreSymbol.format(net),
+		"unstake_cpu_quantity": p.coreSymbol.format(cpu),
+	}
+	return p.pushAuthAction(newAction("eosio", "undelegatebw", args, PermissionLevel{from, "active"}))
+}
+
+// SimulatedBudget is the CPU and net an account can use in a simulation, -1 is unlimited
+type SimulatedBudget struct {
+	CPU      time.Duration
+	NetBytes int64
+}
+
+// Simulation bills transactions with a fixed cost model instead of the CPU time measured by the chain,
+// so exhausting the CPU or net of an account fails at the same transaction in every run. Transactions
+// are billed to the authorizers of their actions, a transaction which exceeds the budget of an account
+// is rolled back and fails with tx_cpu_usage_exceeded or tx_net_usage_exceeded.
+type Simulation struct {
+	// CPU billed for every transaction and for every action trace, including inline actions and notifications
+	CPUPerTransaction time.Duration
+	CPUPerAction      time.Duration
+
+	budgets map[string]SimulatedBudget
+	usage   map[string]SimulatedBudget
+}
+
+// simulatedMethods are the methods of transactions billed by a simulation
+var simulatedMethods = map[string]bool{
+	"push_action":             true,
+	"push_actions":            true,
+	"push_transaction":        true,
+	"execute_deferred":        true,
+	"push_signed_transaction": true,
+}
+
+// EnableSimulation bills the transactions pushed from now on with a simulation, the chain keeps
+// billing them with measured CPU time. While budgets are set, every transaction is pushed in an undo
+// session nested in the current session, which costs two more calls to the server per transaction.
+func (p *ChainTester) EnableSimulation() *Simulation {
+	p.simulation = &Simulation{
+		CPUPerTransaction: 100 * time.Microsecond,
+		CPUPerAction:      100 * time.Microsecond,
+		budgets:           map[string]SimulatedBudget{},
+		usage:             map[string]SimulatedBudget{},
+	}
+	return p.simulation
+}
+
+// DisableSimulation stops billing transactions with the simulation
+func (p *ChainTester) DisableSimulation() {
+	p.simulation = nil
+}
+
+// SetBudget sets the CPU and net account can use, accounts without budget are not limited
+func (s *Simulation) SetBudget(account string, cpu time.Duration, netBytes int64) {
+	s.budgets[account] = SimulatedBudget{cpu, netBytes}
+}
+
+// Usage returns the CPU and net billed to account
+func (s *Simulation) Usage(account string) SimulatedBudget {
+	return s.usage[account]
+}
+
+// Reset clears the usage of every account, like the usage windows of the chain expire
+func (s *Simulation) Reset() {
+	s.usage = map[string]SimulatedBudget{}
+}
+
+type simulatedTrace struct {
+	NetUsage     int64 `json:"net_usage"`
+	ActionTraces []struct {
+		CreatorActionOrdinal int64 `json:"creator_action_ordinal"`
+		Act                  struct {
+			Authorization []PermissionLevel `json:"authorization"`
+		} `json:"act"`
+	} `json:"action_traces"`
+	Except json.RawMessage `json:"except"`
+}
+
+// exceededTrace returns the trace of a failed transaction, the chain fails transactions which exceed their limits like it
+func exceededTrace(code int64, name string, message string) []byte {
+	trace, _ := json.Marshal(map[string]interface{}{
+		"except": map[string]interface{}{
+			"code":    code,
+			"name":    name,
+			"message": message,
+			"stack":   []interface{}{},
+		},
+	})
+	return trace
+}
+
+// bill bills a transaction and returns the trace of the failure if it exceeds a budget
+func (s *Simulation) bill(trace []byte) []byte {
+	var trx simulatedTrace
+	if err := json.Unmarshal(trace, &trx); err != nil || (len(trx.Except) != 0 && string(trx.Except) != "null") {
+		return nil
+	}
+
+	cpu := s.CPUPerTransaction + s.CPUPerAction*time.Duration(len(trx.ActionTraces))
+	var accounts []string
+	billed := map[string]bool{}
+	for _, act := range trx.ActionTraces {
+		if act.CreatorActionOrdinal != 0 {
+			continue
+		}
+		for _, auth := range act.Act.Authorization {
+			if !billed[auth.Actor] {
+				billed[auth.Actor] = true
+				accounts = append(accounts, auth.Actor)
+			}
+		}
+	}
+
+	for _, account := range accounts {
+		budget, ok := s.budgets[account]
+		if !ok {
+			continue
+		}
+		used := s.usage[account]
+		if budget.CPU >= 0 && used.CPU+cpu > budget.CPU {
+			return exceededTrace(3080004, "tx_cpu_usage_exceeded", fmt.Sprintf(
+				"Transaction exceeded the current CPU usage limit imposed on the transaction: billed CPU time (%d us) is greater than the CPU time %s has left (%d us)",
+				cpu.Microseconds(), account, (budget.CPU-used.CPU).Microseconds()))
+		}
+		if budget.NetBytes >= 0 && used.NetBytes+trx.NetUsage > budget.NetBytes {
+			return exceededTrace(3080002, "tx_net_usage_exceeded", fmt.Sprintf(
+				"Transaction exceeded the current network usage limit imposed on the transaction: net usage (%d bytes) is greater than the net %s has left (%d bytes)",
+				trx.NetUsage, account, budget.NetBytes-used.NetBytes))
+		}
+	}
+
+	for _, account := range accounts {
+		used := s.usage[account]
+		used.CPU += cpu
+		used.NetBytes += trx.NetUsage
+		s.usage[account] = used
+	}
+	return nil
+}
+
+// traceOf returns the trace field of the result of a simulated method
+func traceOf(result thrift.TStruct) *[]byte {
+	switch r := result.(type) {
+	case *interfaces.IPCChainTesterPushActionResult:
+		return &r.Success
+	case *interfaces.IPCChainTesterPushActionsResult:
+		return &r.Success
+	case *interfaces.IPCChainTesterPushTransactionResult:
+		return &r.Success
+	case *interfaces.IPCChainTesterExecuteDeferredResult:
+		return &r.Success
+	case *interfaces.IPCChainTesterPushSignedTransactionResult:
+		return &r.Success
+	}
+	return nil
+}
+
+// call pushes a transaction and bills it. If budgets are set the transaction is pushed in an undo
+// session, which is rolled back if the transaction exceeds a budget.
+func (s *Simulation) call(ctx context.Context, p *ChainTester, method string, args, result thrift.TStruct) (thrift.ResponseMeta, error) {
+	trace := traceOf(result)
+	if trace == nil {
+		return thrift.ResponseMeta{}, newErrorf("method %s can not be simulated", method)
+	}
+
+	if len(s.budgets) == 0 {
+		meta, err := p.call(ctx, method, args, result)
+		if err == nil {
+			s.bill(*trace)
+		}
+		return meta, err
+	}
+
+	session, err := p.Begin()
+	if err != nil {
+		return thrift.ResponseMeta{}, err
+	}
+
+	meta, err := p.call(ctx, method, args, result)
+	if err != nil {
+		session.Rollback()
+		return meta, err
+	}
+
+	if exceeded := s.bill(*trace); exceeded != nil {
+		*trace = exceeded
+		return meta, session.Rollback()
+	}
+	return meta, session.Commit()
+}
@@ -0,0 +1,86 @@
+package chaintester
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/uuosio/chaintester/interfaces"
+)
+
+const simulationTestTrace = `{
+	"net_usage": 128,
+	"action_traces": [
+		{"creator_action_ordinal": 0, "act": {"account": "hello", "name": "inc", "authorization": [{"actor": "alice", "permission": "active"}]}},
+		{"creator_action_ordinal": 1, "act": {"account": "hello", "name": "log", "authorization": [{"actor": "hello", "permission": "active"}]}}
+	]
+}`
+
+func TestSimulation(t *testing.T) {
+	s := (&ChainTester{}).EnableSimulation()
+	s.SetBudget("alice", 900*time.Microsecond, -1)
+	s.SetBudget("hello", 0, 0)
+
+	// 100us for the transaction and 100us for every action trace, inline actions bill nobody
+	for i := 0; i < 3; i++ {
+		if trace := s.bill([]byte(simulationTestTrace)); trace != nil {
+			t.Fatalf("transaction %d failed: %s", i, trace)
+		}
+	}
+	if usage := s.Usage("alice"); usage.CPU != 900*time.Microsecond || usage.NetBytes != 384 {
+		t.Errorf("usage %+v", usage)
+	}
+	if usage := s.Usage("hello"); usage.CPU != 0 {
+		t.Errorf("usage of hello %+v", usage)
+	}
+
+	trace := s.bill([]byte(simulationTestTrace))
+	if err := NewTransactionError(trace); err == nil || !strings.Contains(err.Error(), "tx_cpu_usage_exceeded") || !strings.Contains(err.Error(), "billed CPU time (300 us)") {
+		t.Errorf("trace %s", trace)
+	}
+	if usage := s.Usage("alice"); usage.CPU != 900*time.Microsecond {
+		t.Errorf("failed transaction billed %+v", usage)
+	}
+
+	s.Reset()
+	s.SetBudget("alice", -1, 200)
+	if trace := s.bill([]byte(simulationTestTrace)); trace != nil {
+		t.Fatalf("transaction failed: %s", trace)
+	}
+	trace = s.bill([]byte(simulationTestTrace))
+	if err := NewTransactionError(trace); err == nil || !strings.Contains(err.Error(), "tx_net_usage_exceeded") {
+		t.Errorf("trace %s", trace)
+	}
+
+	// failed transactions are not billed
+	if trace := s.bill([]byte(`{"except": {"code": 3050003}}`)); trace != nil {
+		t.Errorf("trace %s", trace)
+	}
+}
+
+func TestTraceOf(t *testing.T) {
+	results := []thrift.TStruct{
+		&interfaces.IPCChainTesterPushActionResult{},
+		&interfaces.IPCChainTesterPushActionsResult{},
+		&interfaces.IPCChainTesterPushTransactionResult{},
+		&interfaces.IPCChainTesterExecuteDeferredResult{},
+		&interfaces.IPCChainTesterPushSignedTransactionResult{},
+	}
+	if len(results) != len(simulatedMethods) {
+		t.Fatalf("%d results of %d simulated methods", len(results), len(simulatedMethods))
+	}
+	for _, result := range results {
+		trace := traceOf(result)
+		if trace == nil {
+			t.Fatalf("no trace in %T", result)
+		}
+		*trace = []byte("{}")
+	}
+	if results[0].(*interfaces.IPCChainTesterPushActionResult).Success == nil {
+		t.Error("trace is not set")
+	}
+	if traceOf(&interfaces.IPCChainTesterGetInfoResult{}) != nil {
+		t.Error("trace of get_info")
+	}
+}
@@ -0,0 +1,363 @@
+package chaintester
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+)
+
+// Attribute is a key value pair describing a span
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+func Attr(key string, value interface{}) Attribute {
+	return Attribute{key, value}
+}
+
+// attributes of the spans of IPC calls
+const (
+	attrService       = "service"
+	attrMethod        = "method"
+	attrChainID       = "chain_id"
+	attrBytesSent     = "bytes_sent"
+	attrBytesReceived = "bytes_received"
+)
+
+// Span is an operation traced by a Tracer, it is ended by End
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// Tracer starts a span for every call to the IPCChainTester and Apply services, named like
+// IPCChainTester.push_action, and for every apply request served by a native apply. The spans
+// have the attributes service, method, chain_id, bytes_sent and bytes_received if known.
+type Tracer interface {
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+type noopTracer struct{}
+
+type noopSpan struct{}
+
+func (noopTracer) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+func (noopSpan) SetAttributes(attrs ...Attribute) {}
+func (noopSpan) RecordError(err error)            {}
+func (noopSpan) End()                             {}
+
+var g_Tracer Tracer = noopTracer{}
+
+// SetTracer traces the calls made from now on with t, a nil t disables tracing
+func SetTracer(t Tracer) {
+	if t == nil {
+		t = noopTracer{}
+	}
+	g_Tracer = t
+}
+
+func GetTracer() Tracer {
+	return g_Tracer
+}
+
+// countingTransport counts the bytes read from and written to a transport
+type countingTransport struct {
+	thrift.TTransport
+	read    int64
+	written int64
+}
+
+func (t *countingTransport) Read(p []byte) (int, error) {
+	n, err := t.TTransport.Read(p)
+	t.read += int64(n)
+	return n, err
+}
+
+func (t *countingTransport) Write(p []byte) (int, error) {
+	n, err := t.TTransport.Write(p)
+	t.written += int64(n)
+	return n, err
+}
+
+// callSpan is the span of a call made by an IPCClient
+type callSpan struct {
+	span          Span
+	transport     *countingTransport
+	read, written int64
+}
+
+// chainIDOf returns the id of the chain a call is made on
+func chainIDOf(service string, args thrift.TStruct) (int32, bool) {
+	if service == "Apply" {
+		return g_ApplyChainTesterId, IsInApply()
+	}
+
+	v := reflect.ValueOf(args)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return 0, false
+	}
+	id := v.FieldByName("ID")
+	if !id.IsValid() || id.Kind() != reflect.Int32 {
+		return 0, false
+	}
+	return int32(id.Int()), true
+}
+
+func (p *IPCClient) startSpan(ctx context.Context, method string, args thrift.TStruct) *callSpan {
+	attrs := []Attribute{Attr(attrService, p.service), Attr(attrMethod, method)}
+	if id, ok := chainIDOf(p.service, args); ok {
+		attrs = append(attrs, Attr(attrChainID, id))
+	}
+	_, span := GetTracer().Start(ctx, p.service+"."+method, attrs...)
+
+	s := &callSpan{span: span}
+	if p.oprot != nil {
+		if t, ok := p.oprot.Transport().(*countingTransport); ok {
+			s.transport = t
+			s.read, s.written = t.read, t.written
+		}
+	}
+	return s
+}
+
+func (s *callSpan) end(err error) {
+	if s.transport != nil {
+		s.span.SetAttributes(
+			Attr(attrBytesSent, s.transport.written-s.written),
+			Attr(attrBytesReceived, s.transport.read-s.read),
+		)
+	}
+	if err != nil {
+		s.span.RecordError(err)
+	}
+	s.span.End()
+}
+
+// SpanStats summarizes the spans of a name
+type SpanStats struct {
+	Name          string
+	Count         int
+	Errors        int
+	Total         time.Duration
+	Min           time.Duration
+	Max           time.Duration
+	BytesSent     int64
+	BytesReceived int64
+}
+
+func (s *SpanStats) Mean() time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Count)
+}
+
+type spanRecord struct {
+	ID         int64                  `json:"id"`
+	Parent     int64                  `json:"parent,omitempty"`
+	Name       string                 `json:"name"`
+	Start      time.Time              `json:"start"`
+	Duration   int64                  `json:"duration_us"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+}
+
+// JSONTracer writes every span to a JSONL file when it ends and summarizes the spans by name.
+// The parent of a span is the innermost span which has not ended, since calls are not concurrent.
+// To report where the time of the tests goes, set it in TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		tracer, err := chaintester.CreateJSONTracer("trace.jsonl")
+//		if err != nil {
+//			panic(err)
+//		}
+//		chaintester.SetTracer(tracer)
+//		code := m.Run()
+//		tracer.Close()
+//		tracer.WriteSummary(os.Stderr)
+//		os.Exit(code)
+//	}
+type JSONTracer struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+	err    error
+	nextID int64
+	open   []*jsonSpan
+	stats  map[string]*SpanStats
+}
+
+// NewJSONTracer returns a tracer which writes spans to w, spans are only summarized if w is nil
+func NewJSONTracer(w io.Writer) *JSONTracer {
+	return &JSONTracer{w: w, stats: map[string]*SpanStats{}}
+}
+
+// CreateJSONTracer returns a tracer which writes spans to file, the file is closed by Close
+func CreateJSONTracer(file string) (*JSONTracer, error) {
+	f, err := os.Create(file)
+	if err != nil {
+		return nil, err
+	}
+	t := NewJSONTracer(f)
+	t.closer = f
+	return t, nil
+}
+
+func (t *JSONTracer) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.nextID++
+	span := &jsonSpan{tracer: t, record: spanRecord{ID: t.nextID, Name: name, Start: time.Now()}}
+	if len(t.open) > 0 {
+		span.record.Parent = t.open[len(t.open)-1].record.ID
+	}
+	span.setAttributes(attrs)
+	t.open = append(t.open, span)
+	return ctx, span
+}
+
+// Close closes the file of a tracer created by CreateJSONTracer and returns the first write error
+func (t *JSONTracer) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closer != nil {
+		if err := t.closer.Close(); err != nil && t.err == nil {
+			t.err = err
+		}
+		t.closer = nil
+	}
+	return t.err
+}
+
+// Summary returns the statistics of the ended spans by name, sorted by total time
+func (t *JSONTracer) Summary() []SpanStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	summary := make([]SpanStats, 0, len(t.stats))
+	for _, stats := range t.stats {
+		summary = append(summary, *stats)
+	}
+	sort.Slice(summary, func(i, j int) bool {
+		if summary[i].Total != summary[j].Total {
+			return summary[i].Total > summary[j].Total
+		}
+		return summary[i].Name < summary[j].Name
+	})
+	return summary
+}
+
+// WriteSummary writes the summary of the spans as a table
+func (t *JSONTracer) WriteSummary(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "span\tcalls\terrors\ttotal\tmean\tmin\tmax\tsent\treceived\t")
+	for _, s := range t.Summary() {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%v\t%v\t%v\t%v\t%d\t%d\t\n", s.Name, s.Count, s.Errors, s.Total, s.Mean(), s.Min, s.Max, s.BytesSent, s.BytesReceived)
+	}
+	return tw.Flush()
+}
+
+func (t *JSONTracer) end(span *jsonSpan) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i := len(t.open) - 1; i >= 0; i-- {
+		if t.open[i] == span {
+			t.open = append(t.open[:i], t.open[i+1:]...)
+			break
+		}
+	}
+
+	duration := time.Since(span.record.Start)
+	span.record.Duration = duration.Microseconds()
+
+	stats, ok := t.stats[span.record.Name]
+	if !ok {
+		stats = &SpanStats{Name: span.record.Name, Min: duration}
+		t.stats[span.record.Name] = stats
+	}
+	stats.Count++
+	stats.Total += duration
+	if duration < stats.Min {
+		stats.Min = duration
+	}
+	if duration > stats.Max {
+		stats.Max = duration
+	}
+	if span.record.Error != "" {
+		stats.Errors++
+	}
+	if n, ok := span.record.Attributes[attrBytesSent].(int64); ok {
+		stats.BytesSent += n
+	}
+	if n, ok := span.record.Attributes[attrBytesReceived].(int64); ok {
+		stats.BytesReceived += n
+	}
+
+	if t.w == nil {
+		return
+	}
+	line, err := json.Marshal(&span.record)
+	if err == nil {
+		_, err = t.w.Write(append(line, '\n'))
+	}
+	if err != nil && t.err == nil {
+		t.err = err
+	}
+}
+
+type jsonSpan struct {
+	tracer *JSONTracer
+	record spanRecord
+	ended  bool
+}
+
+func (s *jsonSpan) setAttributes(attrs []Attribute) {
+	if len(attrs) == 0 {
+		return
+	}
+	if s.record.Attributes == nil {
+		s.record.Attributes = make(map[string]interface{}, len(attrs))
+	}
+	for _, attr := range attrs {
+		s.record.Attributes[attr.Key] = attr.Value
+	}
+}
+
+func (s *jsonSpan) SetAttributes(attrs ...Attribute) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.setAttributes(attrs)
+}
+
+func (s *jsonSpan) RecordError(err error) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.record.Error = err.Error()
+}
+
+func (s *jsonSpan) End() {
+	if s.ended {
+		return
+	}
+	s.ended = true
+	s.tracer.end(s)
+}
@@ -0,0 +1,123 @@
+package chaintester
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/uuosio/chaintester/interfaces"
+)
+
+func readSpans(t *testing.T, data *bytes.Buffer) []spanRecord {
+	var spans []spanRecord
+	scanner := bufio.NewScanner(data)
+	for scanner.Scan() {
+		var span spanRecord
+		if err := json.Unmarshal(scanner.Bytes(), &span); err != nil {
+			t.Fatal(err)
+		}
+		spans = append(spans, span)
+	}
+	return spans
+}
+
+func TestJSONTracer(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := NewJSONTracer(&buf)
+
+	_, outer := tracer.Start(defaultCtx, "IPCChainTester.push_action", Attr(attrChainID, int32(1)))
+	_, inner := tracer.Start(defaultCtx, "Apply.prints")
+	inner.SetAttributes(Attr(attrBytesSent, int64(10)), Attr(attrBytesReceived, int64(20)))
+	inner.End()
+	inner.End()
+	outer.RecordError(newErrorf("assertion failure"))
+	outer.End()
+	_, next := tracer.Start(defaultCtx, "Apply.prints")
+	next.End()
+
+	spans := readSpans(t, &buf)
+	if len(spans) != 3 {
+		t.Fatalf("%d spans written, expected 3", len(spans))
+	}
+	if spans[0].Name != "Apply.prints" || spans[0].Parent != spans[1].ID || spans[2].Parent != 0 {
+		t.Errorf("spans %+v are not nested", spans)
+	}
+	if spans[1].Error != "assertion failure" || spans[1].Attributes[attrChainID] != float64(1) {
+		t.Errorf("span %+v", spans[1])
+	}
+
+	summary := tracer.Summary()
+	if len(summary) != 2 {
+		t.Fatalf("summary %+v", summary)
+	}
+	for _, stats := range summary {
+		switch stats.Name {
+		case "Apply.prints":
+			if stats.Count != 2 || stats.Errors != 0 || stats.BytesSent != 10 || stats.BytesReceived != 20 || stats.Min > stats.Max {
+				t.Errorf("stats %+v", stats)
+			}
+		case "IPCChainTester.push_action":
+			if stats.Count != 1 || stats.Errors != 1 || stats.Mean() != stats.Total {
+				t.Errorf("stats %+v", stats)
+			}
+		default:
+			t.Errorf("unexpected stats %+v", stats)
+		}
+	}
+
+	var report bytes.Buffer
+	if err := tracer.WriteSummary(&report); err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Split(strings.TrimSpace(report.String()), "\n"); len(lines) != 3 || !strings.Contains(lines[0], "calls") {
+		t.Errorf("summary:\n%s", report.String())
+	}
+}
+
+func TestTraceCall(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := NewJSONTracer(&buf)
+	SetTracer(tracer)
+	defer SetTracer(nil)
+
+	// requests are read back from a memory buffer as their results, which fails
+	transport := &countingTransport{TTransport: thrift.NewTMemoryBuffer()}
+	protocol := thrift.NewTBinaryProtocolConf(transport, nil)
+	client := NewIPCClient(protocol, protocol)
+	client.service = "IPCChainTester"
+	tester := interfaces.NewIPCChainTesterClient(client)
+
+	if _, err := tester.GetInfo(defaultCtx, 3); err == nil {
+		t.Fatal("get_info succeeded")
+	}
+
+	spans := readSpans(t, &buf)
+	if len(spans) != 1 {
+		t.Fatalf("%d spans written, expected 1", len(spans))
+	}
+	span := spans[0]
+	if span.Name != "IPCChainTester.get_info" || span.Error == "" {
+		t.Errorf("span %+v", span)
+	}
+	for key, value := range map[string]interface{}{
+		attrService:       "IPCChainTester",
+		attrMethod:        "get_info",
+		attrChainID:       float64(3),
+		attrBytesSent:     float64(transport.written),
+		attrBytesReceived: float64(transport.read),
+	} {
+		if span.Attributes[key] != value {
+			t.Errorf("attribute %s is %v, expected %v", key, span.Attributes[key], value)
+		}
+	}
+	if transport.written == 0 {
+		t.Error("no bytes written")
+	}
+
+	if _, ok := chainIDOf("IPCChainTester", &interfaces.IPCChainTesterCreateKeyArgs{}); ok {
+		t.Error("chain id of create_key")
+	}
+}
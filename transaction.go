@@ -0,0 +1,688 @@
+package chaintester
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/uuosio/chaintester/interfaces"
+	"github.com/uuosio/chaintester/keys"
+)
+
+// DefaultExpiration is the time from the head block to the expiration of a
+// transaction which has no expiration set
+const DefaultExpiration = 30 * time.Second
+
+// Transaction is a builder of a full transaction. Fields which are not set are
+// filled in when the transaction is pushed: expiration defaults to
+// DefaultExpiration after the head block and TAPOS refers to the head block.
+type Transaction struct {
+	Expiration       time.Time
+	RefBlockNum      uint16
+	RefBlockPrefix   uint32
+	MaxNetUsageWords uint32
+	MaxCPUUsageMs    uint8
+	DelaySec         uint32
+
+	ContextFreeActions []*interfaces.Action
+	Actions            []*interfaces.Action
+	ContextFreeData    [][]byte
+
+	tapos bool
+	// the first error occurred while building the transaction
+	err error
+}
+
+func NewTransaction() *Transaction {
+	return &Transaction{}
+}
+
+func (t *Transaction) SetExpiration(expiration time.Time) *Transaction {
+	t.Expiration = expiration
+	return t
+}
+
+// SetTapos sets the reference block of the transaction, the prefix is taken from blockID
+func (t *Transaction) SetTapos(blockNum uint32, blockID string) *Transaction {
+	id, err := hex.DecodeString(blockID)
+	if err != nil || len(id) != 32 {
+		t.setError(newErrorf("invalid block id: %s", blockID))
+		return t
+	}
+	t.RefBlockNum = uint16(blockNum)
+	t.RefBlockPrefix = binary.LittleEndian.Uint32(id[8:12])
+	t.tapos = true
+	return t
+}
+
+func (t *Transaction) SetMaxNetUsageWords(words uint32) *Transaction {
+	t.MaxNetUsageWords = words
+	return t
+}
+
+func (t *Transaction) SetMaxCPUUsageMs(ms uint8) *Transaction {
+	t.MaxCPUUsageMs = ms
+	return t
+}
+
+// SetDelay delays the execution of the transaction, a delayed transaction is
+// scheduled when it is pushed and executed in a later block. The chain counts
+// delays in whole seconds, other delays make the transaction invalid.
+func (t *Transaction) SetDelay(delay time.Duration) *Transaction {
+	if delay < 0 || delay%time.Second != 0 || delay/time.Second > math.MaxUint32 {
+		t.setError(newErrorf("invalid delay %v: must be whole seconds in the range of uint32", delay))
+		return t
+	}
+	t.DelaySec = uint32(delay / time.Second)
+	return t
+}
+
+func (t *Transaction) setError(err error) {
+	if t.err == nil {
+		t.err = err
+	}
+}
+
+func newActionArguments(args interface{}) (*interfaces.ActionArguments, error) {
+	_args := interfaces.NewActionArguments()
+	switch v := args.(type) {
+	case string:
+		_args.JSONArgs_ = &v
+	case []byte:
+		_rawArgs := make([]byte, len(v))
+		copy(_rawArgs, v)
+		_args.RawArgs_ = _rawArgs
+	default:
+		return nil, newErrorf("invalid arguments type: %T", args)
+	}
+	return _args, nil
+}
+
+// AddAction adds an action with arguments in JSON string or raw []byte
+func (t *Transaction) AddAction(account string, action string, args interface{}, auth ...PermissionLevel) *Transaction {
+	_args, err := newActionArguments(args)
+	if err != nil {
+		t.setError(err)
+		return t
+	}
+
+	permissions, err := encodePermissions(auth, false)
+	if err != nil {
+		t.setError(newErrorf("%s::%s: %v", account, action, err))
+		return t
+	}
+
+	t.Actions = append(t.Actions, &interfaces.Action{
+		Account:     account,
+		Action:      action,
+		Arguments:   _args,
+		Permissions: permissions,
+	})
+	return t
+}
+
+// AddContextFreeAction adds a context free action, which has no authorization
+func (t *Transaction) AddContextFreeAction(account string, action string, args interface{}) *Transaction {
+	_args, err := newActionArguments(args)
+	if err != nil {
+		t.setError(err)
+		return t
+	}
+
+	t.ContextFreeActions = append(t.ContextFreeActions, &interfaces.Action{
+		Account:     account,
+		Action:      action,
+		Arguments:   _args,
+		Permissions: "[]",
+	})
+	return t
+}
+
+// AddContextFreeData adds data which can be read by context free actions with GetContextFreeData
+func (t *Transaction) AddContextFreeData(data []byte) *Transaction {
+	_data := make([]byte, len(data))
+	copy(_data, data)
+	t.ContextFreeData = append(t.ContextFreeData, _data)
+	return t
+}
+
+type transactionAction struct {
+	Account       string            `json:"account"`
+	Name          string            `json:"name"`
+	Authorization []PermissionLevel `json:"authorization"`
+	Data          string            `json:"data"`
+}
+
+func (p *ChainTester) packActionArgs(action *interfaces.Action) ([]byte, error) {
+	if action.Arguments.IsSetRawArgs_() {
+		return action.Arguments.RawArgs_, nil
+	}
+	return p.IPCChainTesterClient.PackActionArgs_(defaultCtx, p.id, action.Account, action.Action, action.Arguments.GetJSONArgs_())
+}
+
+func (p *ChainTester) newTransactionAction(action *interfaces.Action) (*transactionAction, error) {
+	auth, err := decodePermissions(action.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := p.packActionArgs(action)
+	if err != nil {
+		return nil, newErrorf("pack args of %s::%s: %v", action.Account, action.Action, err)
+	}
+
+	return &transactionAction{
+		Account:       action.Account,
+		Name:          action.Action,
+		Authorization: auth,
+		Data:          hex.EncodeToString(data),
+	}, nil
+}
+
+type signedTransaction struct {
+	Expiration            string               `json:"expiration"`
+	RefBlockNum           uint16               `json:"ref_block_num"`
+	RefBlockPrefix        uint32               `json:"ref_block_prefix"`
+	MaxNetUsageWords      uint32               `json:"max_net_usage_words"`
+	MaxCPUUsageMs         uint8                `json:"max_cpu_usage_ms"`
+	DelaySec              uint32               `json:"delay_sec"`
+	ContextFreeActions    []*transactionAction `json:"context_free_actions"`
+	Actions               []*transactionAction `json:"actions"`
+	TransactionExtensions []interface{}        `json:"transaction_extensions"`
+	Signatures            []string             `json:"signatures"`
+	ContextFreeData       []string             `json:"context_free_data"`
+
+	expiration time.Time
+}
+
+// resolveTransaction fills in the defaults of trx and packs its action data
+func (p *ChainTester) resolveTransaction(trx *Transaction) (*signedTransaction, *ChainInfo, error) {
+	if trx.err != nil {
+		return nil, nil, trx.err
+	}
+	if len(trx.Actions) == 0 && len(trx.ContextFreeActions) == 0 {
+		return nil, nil, newErrorf("transaction has no actions")
+	}
+
+	info, err := p.GetChainInfo()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	expiration := trx.Expiration
+	if expiration.IsZero() {
+		expiration = info.HeadBlockTime.Add(DefaultExpiration)
+	}
+
+	refBlockNum, refBlockPrefix := trx.RefBlockNum, trx.RefBlockPrefix
+	if !trx.tapos {
+		tapos := NewTransaction().SetTapos(info.HeadBlockNum, info.HeadBlockID)
+		if tapos.err != nil {
+			return nil, nil, tapos.err
+		}
+		refBlockNum, refBlockPrefix = tapos.RefBlockNum, tapos.RefBlockPrefix
+	}
+
+	signed := &signedTransaction{
+		Expiration:            expiration.UTC().Format("2006-01-02T15:04:05"),
+		RefBlockNum:           refBlockNum,
+		RefBlockPrefix:        refBlockPrefix,
+		MaxNetUsageWords:      trx.MaxNetUsageWords,
+		MaxCPUUsageMs:         trx.MaxCPUUsageMs,
+		DelaySec:              trx.DelaySec,
+		ContextFreeActions:    make([]*transactionAction, 0, len(trx.ContextFreeActions)),
+		Actions:               make([]*transactionAction, 0, len(trx.Actions)),
+		TransactionExtensions: []interface{}{},
+		Signatures:            []string{},
+		ContextFreeData:       make([]string, 0, len(trx.ContextFreeData)),
+		expiration:            expiration.Truncate(time.Second),
+	}
+
+	for _, action := range trx.ContextFreeActions {
+		a, err := p.newTransactionAction(action)
+		if err != nil {
+			return nil, nil, err
+		}
+		signed.ContextFreeActions = append(signed.ContextFreeActions, a)
+	}
+
+	for _, action := range trx.Actions {
+		a, err := p.newTransactionAction(action)
+		if err != nil {
+			return nil, nil, err
+		}
+		signed.Actions = append(signed.Actions, a)
+	}
+
+	for _, data := range trx.ContextFreeData {
+		signed.ContextFreeData = append(signed.ContextFreeData, hex.EncodeToString(data))
+	}
+	return signed, info, nil
+}
+
+// packTransaction returns trx in JSON format with packed action data
+func (p *ChainTester) packTransaction(trx *Transaction) (string, error) {
+	signed, _, err := p.resolveTransaction(trx)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(signed)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+type TransactionResult struct {
+	// id of the transaction
+	ID string
+	// Scheduled is true if the execution of the transaction is delayed
+	Scheduled bool
+	Trace     *JsonValue
+}
+
+// PushTransaction pushes a full transaction, a transaction with delay is
+// scheduled and executed by a later block
+func (p *ChainTester) PushTransaction(trx *Transaction) (*TransactionResult, error) {
+	packed, err := p.packTransaction(trx)
+	if err != nil {
+		return nil, err
+	}
+
+	var _args interfaces.IPCChainTesterPushTransactionArgs
+	_args.ID = p.id
+	_args.Transaction = packed
+	var _result interfaces.IPCChainTesterPushTransactionResult
+	var _meta thrift.ResponseMeta
+
+	var _err error
+	_meta, _err = p.Call(defaultCtx, "push_transaction", &_args, &_result)
+	p.SetLastResponseMeta_(_meta)
+	if _err != nil {
+		return nil, _err
+	}
+
+	return p.newTransactionResult(_result.GetSuccess())
+}
+
+func (p *ChainTester) newTransactionResult(ret []byte) (*TransactionResult, error) {
+	value, err := p.parseTrace(ret)
+	if err != nil {
+		return nil, err
+	}
+
+	var trace struct {
+		ID        string `json:"id"`
+		Scheduled bool   `json:"scheduled"`
+		Receipt   struct {
+			Status string `json:"status"`
+		} `json:"receipt"`
+	}
+	if err := json.Unmarshal(ret, &trace); err != nil {
+		return nil, newError(err)
+	}
+
+	return &TransactionResult{
+		ID:        trace.ID,
+		Scheduled: trace.Scheduled || trace.Receipt.Status == "delayed",
+		Trace:     value,
+	}, nil
+}
+
+func writeVarUint32(buf *bytes.Buffer, v uint32) {
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			b |= 0x80
+		}
+		buf.WriteByte(b)
+		if v == 0 {
+			return
+		}
+	}
+}
+
+func writeBytes(buf *bytes.Buffer, data []byte) {
+	writeVarUint32(buf, uint32(len(data)))
+	buf.Write(data)
+}
+
+func writeName(buf *bytes.Buffer, name string) error {
+	if err := checkName("name", name); err != nil {
+		return err
+	}
+	return binary.Write(buf, binary.LittleEndian, S2N(name))
+}
+
+func packActions(buf *bytes.Buffer, actions []*transactionAction) error {
+	writeVarUint32(buf, uint32(len(actions)))
+	for _, action := range actions {
+		if err := writeName(buf, action.Account); err != nil {
+			return err
+		}
+		if err := writeName(buf, action.Name); err != nil {
+			return err
+		}
+
+		writeVarUint32(buf, uint32(len(action.Authorization)))
+		for _, level := range action.Authorization {
+			if err := writeName(buf, level.Actor); err != nil {
+				return err
+			}
+			if err := writeName(buf, level.Permission); err != nil {
+				return err
+			}
+		}
+
+		data, err := hex.DecodeString(action.Data)
+		if err != nil {
+			return err
+		}
+		writeBytes(buf, data)
+	}
+	return nil
+}
+
+// pack serializes the transaction without signatures and context free data
+func (t *signedTransaction) pack() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	binary.Write(buf, binary.LittleEndian, uint32(t.expiration.Unix()))
+	binary.Write(buf, binary.LittleEndian, t.RefBlockNum)
+	binary.Write(buf, binary.LittleEndian, t.RefBlockPrefix)
+	writeVarUint32(buf, t.MaxNetUsageWords)
+	buf.WriteByte(t.MaxCPUUsageMs)
+	writeVarUint32(buf, t.DelaySec)
+
+	if err := packActions(buf, t.ContextFreeActions); err != nil {
+		return nil, err
+	}
+	if err := packActions(buf, t.Actions); err != nil {
+		return nil, err
+	}
+	// transaction_extensions
+	writeVarUint32(buf, 0)
+	return buf.Bytes(), nil
+}
+
+func readVarUint32(r *bytes.Reader) (uint32, error) {
+	var v uint32
+	for shift := uint(0); shift < 35; shift += 7 {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		v |= uint32(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return v, nil
+		}
+	}
+	return 0, newErrorf("invalid varuint32")
+}
+
+func readName(r *bytes.Reader) (string, error) {
+	var n uint64
+	if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+		return "", err
+	}
+	return N2S(n), nil
+}
+
+func unpackActions(r *bytes.Reader) ([]*transactionAction, error) {
+	count, err := readVarUint32(r)
+	if err != nil {
+		return nil, err
+	}
+	actions := []*transactionAction{}
+	for i := uint32(0); i < count; i++ {
+		action := &transactionAction{}
+		if action.Account, err = readName(r); err != nil {
+			return nil, err
+		}
+		if action.Name, err = readName(r); err != nil {
+			return nil, err
+		}
+
+		levels, err := readVarUint32(r)
+		if err != nil {
+			return nil, err
+		}
+		action.Authorization = []PermissionLevel{}
+		for j := uint32(0); j < levels; j++ {
+			var level PermissionLevel
+			if level.Actor, err = readName(r); err != nil {
+				return nil, err
+			}
+			if level.Permission, err = readName(r); err != nil {
+				return nil, err
+			}
+			action.Authorization = append(action.Authorization, level)
+		}
+
+		size, err := readVarUint32(r)
+		if err != nil {
+			return nil, err
+		}
+		if int64(size) > int64(r.Len()) {
+			return nil, newErrorf("action data out of range")
+		}
+		data := make([]byte, size)
+		r.Read(data)
+		action.Data = hex.EncodeToString(data)
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+// unpackTransaction deserializes a transaction packed by pack
+func unpackTransaction(packed []byte) (*signedTransaction, error) {
+	r := bytes.NewReader(packed)
+	trx := &signedTransaction{
+		TransactionExtensions: []interface{}{},
+		ContextFreeData:       []string{},
+	}
+
+	var expiration uint32
+	if err := binary.Read(r, binary.LittleEndian, &expiration); err != nil {
+		return nil, newErrorf("invalid packed transaction: %v", err)
+	}
+	trx.expiration = time.Unix(int64(expiration), 0)
+	trx.Expiration = trx.expiration.UTC().Format("2006-01-02T15:04:05")
+	if err := binary.Read(r, binary.LittleEndian, &trx.RefBlockNum); err != nil {
+		return nil, newErrorf("invalid packed transaction: %v", err)
+	}
+	if err := binary.Read(r, binary.LittleEndian, &trx.RefBlockPrefix); err != nil {
+		return nil, newErrorf("invalid packed transaction: %v", err)
+	}
+
+	var err error
+	if trx.MaxNetUsageWords, err = readVarUint32(r); err != nil {
+		return nil, newErrorf("invalid packed transaction: %v", err)
+	}
+	if trx.MaxCPUUsageMs, err = r.ReadByte(); err != nil {
+		return nil, newErrorf("invalid packed transaction: %v", err)
+	}
+	if trx.DelaySec, err = readVarUint32(r); err != nil {
+		return nil, newErrorf("invalid packed transaction: %v", err)
+	}
+	if trx.ContextFreeActions, err = unpackActions(r); err != nil {
+		return nil, newErrorf("invalid packed transaction: %v", err)
+	}
+	if trx.Actions, err = unpackActions(r); err != nil {
+		return nil, newErrorf("invalid packed transaction: %v", err)
+	}
+
+	extensions, err := readVarUint32(r)
+	if err != nil {
+		return nil, newErrorf("invalid packed transaction: %v", err)
+	}
+	if extensions != 0 {
+		return nil, newErrorf("transaction extensions are not supported")
+	}
+	if r.Len() != 0 {
+		return nil, newErrorf("invalid packed transaction: %d extra bytes", r.Len())
+	}
+	return trx, nil
+}
+
+// contextFreeDataDigest returns the digest of context free data, or zeros if there is no data
+func (t *signedTransaction) contextFreeDataDigest() ([]byte, error) {
+	if len(t.ContextFreeData) == 0 {
+		return make([]byte, 32), nil
+	}
+
+	buf := &bytes.Buffer{}
+	writeVarUint32(buf, uint32(len(t.ContextFreeData)))
+	for _, data := range t.ContextFreeData {
+		_data, err := hex.DecodeString(data)
+		if err != nil {
+			return nil, err
+		}
+		writeBytes(buf, _data)
+	}
+	digest := sha256.Sum256(buf.Bytes())
+	return digest[:], nil
+}
+
+// SignedTransaction is a transaction signed locally by SignTransaction
+type SignedTransaction struct {
+	ID         string
+	PackedTrx  []byte
+	Signatures []string
+	// Digest is the digest signed by the signatures, which covers the chain id,
+	// the packed transaction and the context free data
+	Digest []byte
+
+	trx *signedTransaction
+}
+
+// Sign adds a signature of signer, signer can be a custom Signer of keys not in the key store
+func (s *SignedTransaction) Sign(signer Signer) error {
+	sig, err := signer.Sign(s.Digest)
+	if err != nil {
+		return err
+	}
+	s.Signatures = append(s.Signatures, sig)
+	return nil
+}
+
+// RecoverKeys returns the public keys of K1 and R1 signatures in PUB_K1_/PUB_R1_ format
+func (s *SignedTransaction) RecoverKeys() ([]string, error) {
+	pubKeys := make([]string, 0, len(s.Signatures))
+	for _, sig := range s.Signatures {
+		signature, err := keys.ParseSignature(sig)
+		if err != nil {
+			return nil, newError(err)
+		}
+		pubKey, err := signature.RecoverPublicKey(s.Digest)
+		if err != nil {
+			return nil, newError(err)
+		}
+		pubKeys = append(pubKeys, pubKey.String())
+	}
+	return pubKeys, nil
+}
+
+func newSignedTransaction(trx *signedTransaction, chainID string) (*SignedTransaction, error) {
+	packed, err := trx.pack()
+	if err != nil {
+		return nil, err
+	}
+
+	_chainID, err := hex.DecodeString(chainID)
+	if err != nil || len(_chainID) != 32 {
+		return nil, newErrorf("invalid chain id: %s", chainID)
+	}
+
+	cfdDigest, err := trx.contextFreeDataDigest()
+	if err != nil {
+		return nil, err
+	}
+
+	id := sha256.Sum256(packed)
+	h := sha256.New()
+	h.Write(_chainID)
+	h.Write(packed)
+	h.Write(cfdDigest)
+
+	return &SignedTransaction{
+		ID:         hex.EncodeToString(id[:]),
+		PackedTrx:  packed,
+		Signatures: []string{},
+		Digest:     h.Sum(nil),
+		trx:        trx,
+	}, nil
+}
+
+// SignTransaction signs trx locally with keys in the key store. If no keys are given,
+// the keys required by the authorizations of trx are looked up with GetRequiredKeys.
+func (p *ChainTester) SignTransaction(trx *Transaction, keys ...string) (*SignedTransaction, error) {
+	resolved, info, err := p.resolveTransaction(trx)
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := newSignedTransaction(resolved, info.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) == 0 {
+		data, err := json.Marshal(resolved)
+		if err != nil {
+			return nil, err
+		}
+		keys, err = p.GetRequiredKeys(string(data), p.KeyStore().Keys())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, key := range keys {
+		signer, ok := p.KeyStore().Signer(key)
+		if !ok {
+			return nil, newErrorf("key %s is not in the key store", key)
+		}
+		if err := signed.Sign(signer); err != nil {
+			return nil, err
+		}
+	}
+	return signed, nil
+}
+
+// PushSignedTransaction pushes PackedTrx with Signatures as is, the server does not add signatures to it.
+// Context free data is only pushed for transactions signed by SignTransaction, PackedTrx does not contain it.
+func (p *ChainTester) PushSignedTransaction(signed *SignedTransaction) (*TransactionResult, error) {
+	trx, err := unpackTransaction(signed.PackedTrx)
+	if err != nil {
+		return nil, err
+	}
+	if signed.trx != nil {
+		trx.ContextFreeData = signed.trx.ContextFreeData
+	}
+	trx.Signatures = append([]string{}, signed.Signatures...)
+	data, err := json.Marshal(trx)
+	if err != nil {
+		return nil, err
+	}
+
+	var _args interfaces.IPCChainTesterPushSignedTransactionArgs
+	_args.ID = p.id
+	_args.SignedTransaction = string(data)
+	var _result interfaces.IPCChainTesterPushSignedTransactionResult
+	var _meta thrift.ResponseMeta
+
+	var _err error
+	_meta, _err = p.Call(defaultCtx, "push_signed_transaction", &_args, &_result)
+	p.SetLastResponseMeta_(_meta)
+	if _err != nil {
+		return nil, _err
+	}
+	return p.newTransactionResult(_result.GetSuccess())
+}
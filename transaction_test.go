@@ -0,0 +1,51 @@
+package chaintester
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTransaction(t *testing.T) {
+	blockID := "0000000a1c9ee7d5e2bd1a6b3c0a95d8f46a0dc63b2c1bb45f5d2d6e0a7c8e9f"
+	trx := NewTransaction().
+		SetTapos(0x1000a, blockID).
+		SetDelay(3*time.Second).
+		AddAction("hello", "inc", "", PermissionLevel{"hello", "active"}).
+		AddContextFreeAction("hello", "test", []byte{}).
+		AddContextFreeData([]byte("hello"))
+	if trx.err != nil {
+		t.Fatal(trx.err)
+	}
+
+	if trx.RefBlockNum != 0xa || trx.RefBlockPrefix != 0x6b1abde2 {
+		t.Fatalf("bad tapos: %d %x", trx.RefBlockNum, trx.RefBlockPrefix)
+	}
+	if trx.DelaySec != 3 {
+		t.Fatalf("bad delay: %d", trx.DelaySec)
+	}
+	if len(trx.Actions) != 1 || len(trx.ContextFreeActions) != 1 || len(trx.ContextFreeData) != 1 {
+		t.Fatalf("bad transaction: %+v", trx)
+	}
+
+	invalid := []*Transaction{
+		NewTransaction().SetTapos(1, "0000"),
+		NewTransaction().AddAction("hello", "inc", 1, PermissionLevel{"hello", "active"}),
+		NewTransaction().AddAction("hello", "inc", ""),
+		NewTransaction().AddContextFreeAction("hello", "test", nil),
+		NewTransaction().SetDelay(3*time.Second + 500*time.Millisecond),
+		NewTransaction().SetDelay(-time.Second),
+		NewTransaction().SetDelay((math.MaxUint32 + 1) * time.Second),
+	}
+	for i, trx := range invalid {
+		if trx.err == nil {
+			t.Errorf("transaction %d should be invalid", i)
+		}
+	}
+
+	// the first error is kept
+	trx = NewTransaction().AddAction("hello", "inc", "").SetTapos(1, "0000")
+	if trx.err == nil || trx.err.Error() != "hello::inc: action has no authorization" {
+		t.Fatalf("bad error: %v", trx.err)
+	}
+}